@@ -9,19 +9,42 @@ import (
 )
 
 type gameRepository struct {
-	games sync.Map
+	games  sync.Map
+	phases map[domain.Phase]map[string]*domain.Game
+	mu     sync.RWMutex
 }
 
 func NewGameRepository() repository.GameRepository {
-	return &gameRepository{}
+	return &gameRepository{
+		phases: make(map[domain.Phase]map[string]*domain.Game),
+	}
 }
 
 func (reposiotry *gameRepository) Store(game *domain.Game) {
 	reposiotry.games.Store(game.ID, game)
+
+	phase := game.Snapshot().Phase
+
+	reposiotry.mu.Lock()
+	defer reposiotry.mu.Unlock()
+
+	reposiotry.unindex(game.ID)
+
+	games, ok := reposiotry.phases[phase]
+	if !ok {
+		games = make(map[string]*domain.Game)
+		reposiotry.phases[phase] = games
+	}
+	games[game.ID] = game
 }
 
 func (reposiotry *gameRepository) Delete(id string) {
 	reposiotry.games.Delete(id)
+
+	reposiotry.mu.Lock()
+	defer reposiotry.mu.Unlock()
+
+	reposiotry.unindex(id)
 }
 
 func (reposiotry *gameRepository) Load(id string) (game *domain.Game, err error) {
@@ -35,3 +58,21 @@ func (reposiotry *gameRepository) Load(id string) (game *domain.Game, err error)
 
 	return
 }
+
+func (reposiotry *gameRepository) FindByPhase(phase domain.Phase) (games []*domain.Game) {
+	reposiotry.mu.RLock()
+	defer reposiotry.mu.RUnlock()
+
+	games = make([]*domain.Game, 0, len(reposiotry.phases[phase]))
+	for _, game := range reposiotry.phases[phase] {
+		games = append(games, game)
+	}
+
+	return
+}
+
+func (reposiotry *gameRepository) unindex(id string) {
+	for _, games := range reposiotry.phases {
+		delete(games, id)
+	}
+}
@@ -8,4 +8,7 @@ const (
 	PhaseChanged
 	PhaseChangedWithoutKilling
 	GameOver
+	PlayerShot
+	PlayerForfeited
+	RunoffStarted
 )
@@ -0,0 +1,9 @@
+package domain
+
+type Channel int
+
+const (
+	PublicChannel Channel = iota
+	WerewolfChannel
+	GraveyardChannel
+)
@@ -1,6 +1,68 @@
 package domain
 
+import "time"
+
 type Config struct {
-	PlayerNum   int
-	WerewolfNum int
+	PlayerNum             int
+	RoleNums              map[Role]int
+	AllowConsecutiveGuard bool
+	DiscussionTime        time.Duration
+	VotingTime            time.Duration
+	NightTime             time.Duration
+	RandomKillOnTimeout   bool
+	SpectatorsSeeRoles    bool
+	KillTieBreak          KillTieBreak
+	RunoffVoters          RunoffVoters
+	ExecutionTieBreak     ExecutionTieBreak
+	OpenBallots           bool
+	Scapegoat             bool
+	ScapegoatVillagerOnly bool
+}
+
+func (config Config) isValid() bool {
+	if config.PlayerNum < 3 || config.DiscussionTime < 0 ||
+		config.VotingTime < 0 || config.NightTime < 0 {
+		return false
+	}
+
+	if config.KillTieBreak < KillRandomly || config.KillTieBreak > KillByLeadWolf ||
+		config.RunoffVoters < EveryoneVotes || config.RunoffVoters > NonCandidatesVote ||
+		config.ExecutionTieBreak < ExecuteNobody || config.ExecutionTieBreak > ExecuteRandomly {
+		return false
+	}
+
+	sum := 0
+	for role, num := range config.RoleNums {
+		if role <= Unkown || role > Cupid || num < 0 {
+			return false
+		}
+		sum += num
+	}
+
+	playerNum := config.PlayerNum
+	if config.Scapegoat {
+		playerNum++
+
+		if config.ScapegoatVillagerOnly && config.RoleNums[Villager] < 1 {
+			return false
+		}
+
+		villagersNum := 0
+		for role, num := range config.RoleNums {
+			if role.Side() == Villagers {
+				villagersNum += num
+			}
+		}
+		if villagersNum < 1 {
+			return false
+		}
+	}
+
+	if sum != playerNum {
+		return false
+	}
+
+	werewolfNum := config.RoleNums[Werewolf]
+
+	return werewolfNum >= 1 && 2*werewolfNum < config.PlayerNum
 }
@@ -0,0 +1,6 @@
+package domain
+
+type Death struct {
+	PlayerID uint
+	Cause    DeathCause
+}
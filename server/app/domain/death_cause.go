@@ -0,0 +1,12 @@
+package domain
+
+type DeathCause int
+
+const (
+	Executed DeathCause = iota
+	Attacked
+	Cursed
+	Shot
+	Suicide
+	Forfeited
+)
@@ -0,0 +1,8 @@
+package domain
+
+type ExecutionTieBreak int
+
+const (
+	ExecuteNobody ExecutionTieBreak = iota
+	ExecuteRandomly
+)
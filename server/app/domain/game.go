@@ -5,45 +5,102 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
-	math_rand "math/rand"
 	"sort"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/ahmetb/go-linq/v3"
 )
 
+const (
+	maxMessageLength   = 500
+	observerBufferSize = 64
+	stateHistorySize   = 256
+	scapegoatID        = ^uint(0)
+	scapegoatName      = "Scapegoat"
+)
+
 type Game struct {
-	ID            string
-	Config        Config
-	Phase         Phase
-	Day           int
-	players       map[uint]*Player
-	stateChangeds sync.Map
-	votings       map[uint]uint
-	nextRequests  map[uint]bool
-	mu            sync.RWMutex
-}
-
-func NewGame(config Config) (game *Game, err error) {
-	if config.PlayerNum < 3 || config.WerewolfNum < 1 ||
-		2*config.WerewolfNum >= config.PlayerNum {
+	ID                 string
+	Config             Config
+	Phase              Phase
+	Day                int
+	OwnerID            uint
+	IsPrivate          bool
+	Seed               int64
+	CreatedTime        time.Time
+	players            map[uint]*Player
+	spectators         map[uint]bool
+	stateChangeds      map[uint]*StateObserver
+	sequence           uint64
+	history            []StateChange
+	messages           []Message
+	messageds          map[uint]*MessageObserver
+	privateEvents      map[uint][]PrivateEvent
+	privateEventeds    map[uint]*PrivateEventObserver
+	votings            map[uint]uint
+	lockIns            map[uint]bool
+	divinations        map[uint]uint
+	guards             map[uint]uint
+	lastGuards         map[uint]uint
+	bindings           map[uint][2]uint
+	executedID         uint
+	runoffCandidateIDs []uint
+	hunterIDs          []uint
+	nextPhase          Phase
+	nextRequests       map[uint]bool
+	deadline           time.Time
+	timer              *time.Timer
+	onTimeout          func(state State)
+	random             Random
+	mu                 sync.RWMutex
+}
+
+func NewGame(config Config, random Random) (game *Game, err error) {
+	if !config.isValid() {
 		return nil, errors.New("invalid argument")
 	}
 
+	if random == nil {
+		random, err = NewRandom()
+		if err != nil {
+			return
+		}
+	}
+
+	roleNums := make(map[Role]int)
+	for role, num := range config.RoleNums {
+		roleNums[role] = num
+	}
+	config.RoleNums = roleNums
+
 	id, err := generateGameID()
 	if err != nil {
 		return
 	}
 
 	game = &Game{
-		ID:           id,
-		Config:       config,
-		Phase:        Start,
-		Day:          1,
-		players:      make(map[uint]*Player),
-		votings:      make(map[uint]uint),
-		nextRequests: make(map[uint]bool),
+		ID:              id,
+		Config:          config,
+		Phase:           Start,
+		Day:             1,
+		Seed:            random.Seed(),
+		CreatedTime:     time.Now(),
+		players:         make(map[uint]*Player),
+		spectators:      make(map[uint]bool),
+		stateChangeds:   make(map[uint]*StateObserver),
+		messageds:       make(map[uint]*MessageObserver),
+		privateEvents:   make(map[uint][]PrivateEvent),
+		privateEventeds: make(map[uint]*PrivateEventObserver),
+		votings:         make(map[uint]uint),
+		lockIns:         make(map[uint]bool),
+		divinations:     make(map[uint]uint),
+		guards:          make(map[uint]uint),
+		lastGuards:      make(map[uint]uint),
+		bindings:        make(map[uint][2]uint),
+		nextRequests:    make(map[uint]bool),
+		random:          random,
 	}
 
 	return
@@ -73,11 +130,33 @@ func (game *Game) Join(playerID uint, playerName string) (state State, err error
 	}
 
 	game.players[playerID] = player
+	delete(game.spectators, playerID)
+
+	if game.OwnerID == 0 {
+		game.OwnerID = playerID
+	}
+
+	oldPhase := game.Phase
 
 	game.update()
 
 	state = game.snapshot()
 
+	game.notify(StateChange{
+		State:         state,
+		ChangeType:    PlayerJoined,
+		OldPhase:      oldPhase,
+		AddedPlayerID: playerID,
+	})
+
+	if state.Phase != oldPhase {
+		game.notify(StateChange{
+			State:      state,
+			ChangeType: PhaseChanged,
+			OldPhase:   oldPhase,
+		})
+	}
+
 	return
 }
 
@@ -95,11 +174,76 @@ func (game *Game) Leave(playerID uint) (state State, err error) {
 		return
 	}
 
+	delete(game.players, playerID)
+	game.unobserveState(playerID)
+	game.unobserveMessages(playerID)
+	game.unobservePrivateEvents(playerID)
+
+	if game.OwnerID == playerID {
+		game.OwnerID = 0
+
+		var ownerJoinedTime time.Time
+		for _, p := range game.players {
+			if game.OwnerID == 0 || p.JoinedTime.Before(ownerJoinedTime) {
+				game.OwnerID = p.ID
+				ownerJoinedTime = p.JoinedTime
+			}
+		}
+	}
+
+	state = game.snapshot()
+
+	game.notify(StateChange{
+		State:        state,
+		ChangeType:   PlayerLeft,
+		OldPhase:     game.Phase,
+		LeftPlayerID: playerID,
+	})
+
+	return
+}
+
+func (game *Game) Spectate(userID uint) (state State, err error) {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+
+	if game.Phase == End {
+		err = errors.New("invalid phase")
+		return
+	}
+
+	if _, ok := game.players[userID]; ok {
+		err = errors.New("already joined")
+		return
+	}
+
+	if game.spectators[userID] {
+		err = errors.New("already spectating")
+		return
+	}
+
+	game.spectators[userID] = true
+
 	state = game.snapshot()
 
 	return
 }
 
+func (game *Game) StopSpectating(userID uint) (err error) {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+
+	if !game.spectators[userID] {
+		err = errors.New("not spectating")
+		return
+	}
+
+	delete(game.spectators, userID)
+	game.unobserveState(userID)
+
+	return
+}
+
 func (game *Game) setRoles() {
 	players := make([]*Player, len(game.players))
 	i := 0
@@ -109,6 +253,9 @@ func (game *Game) setRoles() {
 	}
 
 	sort.Slice(players, func(i, j int) bool {
+		if players[i].JoinedTime.Equal(players[j].JoinedTime) {
+			return players[i].ID < players[j].ID
+		}
 		return players[i].JoinedTime.Before(players[j].JoinedTime)
 	})
 
@@ -116,17 +263,59 @@ func (game *Game) setRoles() {
 		players[i].Index = i + 1
 	}
 
-	for i := 0; i < game.Config.WerewolfNum; i++ {
-		r := math_rand.Intn(len(players))
-		players[r].Role = Werewolf
-		players[r].Side = Werewolves
-		players = append(players[:r], players[r+1:]...)
+	roleNums := make(map[Role]int)
+	roles := make([]Role, 0, len(game.Config.RoleNums))
+	for role, num := range game.Config.RoleNums {
+		roleNums[role] = num
+		roles = append(roles, role)
+	}
+
+	sort.Slice(roles, func(i, j int) bool {
+		return roles[i] < roles[j]
+	})
+
+	for i, p := range players {
+		if p.IsDummy {
+			role := game.getScapegoatRole(roles)
+			p.Role = role
+			p.Side = role.Side()
+			roleNums[role]--
+			players = append(players[:i], players[i+1:]...)
+			break
+		}
+	}
+
+	for _, role := range roles {
+		players = game.assignRole(players, role, roleNums[role])
+	}
+}
+
+func (game *Game) getScapegoatRole(roles []Role) Role {
+	if game.Config.ScapegoatVillagerOnly {
+		return Villager
+	}
+
+	candidates := []Role{}
+	for _, role := range roles {
+		if role.Side() != Villagers {
+			continue
+		}
+		for i := 0; i < game.Config.RoleNums[role]; i++ {
+			candidates = append(candidates, role)
+		}
 	}
 
-	for _, player := range players {
-		player.Role = Villager
-		player.Side = Villagers
+	return candidates[game.random.Intn(len(candidates))]
+}
+
+func (game *Game) assignRole(players []*Player, role Role, num int) []*Player {
+	for i := 0; i < num; i++ {
+		r := game.random.Intn(len(players))
+		players[r].Role = role
+		players[r].Side = role.Side()
+		players = append(players[:r], players[r+1:]...)
 	}
+	return players
 }
 
 func (game *Game) Vote(playerID uint, targetID uint) (state State, pahseResult PhaseResult, err error) {
@@ -140,6 +329,7 @@ func (game *Game) Vote(playerID uint, targetID uint) (state State, pahseResult P
 
 	if player.IsDied {
 		err = errors.New("player is already died")
+		return
 	}
 
 	if targetID > 0 {
@@ -165,18 +355,31 @@ func (game *Game) Vote(playerID uint, targetID uint) (state State, pahseResult P
 		return
 	}
 
-	if _, ok := game.votings[playerID]; ok {
-		err = errors.New("already voted")
+	if !game.canVote(player) {
+		err = errors.New("cannot vote in runoff")
+		return
+	}
+
+	if targetID > 0 && len(game.runoffCandidateIDs) > 0 && !game.isRunoffCandidate(targetID) {
+		err = errors.New("target player is not runoff candidate")
+		return
+	}
+
+	if game.lockIns[playerID] {
+		err = errors.New("already locked in")
 		return
 	}
 
 	game.votings[playerID] = targetID
-	game.nextRequests[playerID] = true
+
+	oldPhase := game.Phase
 
 	pahseResult = game.update()
 
 	state = game.snapshot()
 
+	game.notifyPhaseChangedIfNeeded(oldPhase, state, pahseResult)
+
 	return
 }
 
@@ -191,6 +394,12 @@ func (game *Game) Kill(playerID uint, targetID uint) (state State, pahseResult P
 
 	if player.Role != Werewolf {
 		err = errors.New("player is not werewolf")
+		return
+	}
+
+	if player.IsDied {
+		err = errors.New("player is already died")
+		return
 	}
 
 	target, err := game.getPlayer(targetID)
@@ -213,232 +422,1390 @@ func (game *Game) Kill(playerID uint, targetID uint) (state State, pahseResult P
 		return
 	}
 
-	if _, ok := game.votings[playerID]; ok {
-		err = errors.New("already voted")
+	if game.lockIns[playerID] {
+		err = errors.New("already locked in")
 		return
 	}
 
 	game.votings[playerID] = targetID
-	game.nextRequests[playerID] = true
+	game.notifyKillVotesChanged()
+
+	oldPhase := game.Phase
 
 	pahseResult = game.update()
 
 	state = game.snapshot()
 
+	game.notifyPhaseChangedIfNeeded(oldPhase, state, pahseResult)
+
 	return
 }
 
-func (game *Game) Next(playerID uint) (state State, pahseResult PhaseResult, err error) {
+func (game *Game) LockIn(playerID uint) (state State, pahseResult PhaseResult, err error) {
 	game.mu.Lock()
 	defer game.mu.Unlock()
 
-	_, err = game.getPlayer(playerID)
+	player, err := game.getPlayer(playerID)
 	if err != nil {
 		return
 	}
 
-	if game.Phase != Night && game.Phase != Noon {
-		err = errors.New("invalid phase")
+	if err = game.validateVoter(player); err != nil {
 		return
 	}
 
-	if _, ok := game.nextRequests[playerID]; ok {
-		err = errors.New("already requested")
+	if _, ok := game.votings[playerID]; !ok {
+		err = errors.New("not voted")
+		return
+	}
+
+	if game.lockIns[playerID] {
+		err = errors.New("already locked in")
 		return
 	}
 
+	game.lockIns[playerID] = true
 	game.nextRequests[playerID] = true
 
+	if game.Phase == Night {
+		game.notifyKillVotesChanged()
+	}
+
+	oldPhase := game.Phase
+
 	pahseResult = game.update()
 
 	state = game.snapshot()
 
+	game.notifyPhaseChangedIfNeeded(oldPhase, state, pahseResult)
+
 	return
 }
 
-func (game *Game) GetRoles(playerID uint) (roles map[uint]Role, err error) {
-	game.mu.RLock()
-	defer game.mu.RUnlock()
+func (game *Game) RetractVote(playerID uint) (err error) {
+	game.mu.Lock()
+	defer game.mu.Unlock()
 
 	player, err := game.getPlayer(playerID)
 	if err != nil {
 		return
 	}
 
-	if game.Phase == Start {
-		err = errors.New("invalid phase")
+	if err = game.validateVoter(player); err != nil {
 		return
 	}
 
-	roles = map[uint]Role{player.ID: player.Role}
+	if _, ok := game.votings[playerID]; !ok {
+		err = errors.New("not voted")
+		return
+	}
 
-	if player.Role == Werewolf {
-		for k, p := range game.players {
-			if p.Role == Werewolf {
-				roles[k] = p.Role
-			}
-		}
+	if game.lockIns[playerID] {
+		err = errors.New("already locked in")
+		return
+	}
+
+	delete(game.votings, playerID)
+
+	if game.Phase == Night {
+		game.notifyKillVotesChanged()
 	}
 
 	return
 }
 
-func (game *Game) update() (phaseResult PhaseResult) {
-	phase := game.Phase
-	day := game.Day
+func (game *Game) GetMyVote(playerID uint) (targetID uint, voted bool, locked bool, err error) {
+	game.mu.RLock()
+	defer game.mu.RUnlock()
 
-	switch {
-	case phase == Start:
-		if len(game.players) == game.Config.PlayerNum {
-			game.Phase = Night
-			game.Day = 1
+	player, err := game.getPlayer(playerID)
+	if err != nil {
+		return
+	}
 
-			game.setRoles()
+	if err = game.validateVoter(player); err != nil {
+		return
+	}
+
+	targetID, voted = game.votings[playerID]
+	locked = game.lockIns[playerID]
+
+	return
+}
+
+func (game *Game) validateVoter(player *Player) error {
+	if player.IsDied {
+		return errors.New("player is already died")
+	}
+
+	switch {
+	case game.Phase == Noon:
+		if !game.canVote(player) {
+			return errors.New("cannot vote in runoff")
 		}
-	case phase == Night && day == 1:
-		if len(game.nextRequests) == game.Config.PlayerNum {
-			game.Phase = Noon
-			game.nextRequests = make(map[uint]bool)
+	case game.Phase == Night && game.Day > 1:
+		if player.Role != Werewolf {
+			return errors.New("player is not werewolf")
 		}
-	case phase == Night:
-		if len(game.votings) == game.Config.WerewolfNum &&
-			len(game.nextRequests) == game.Config.PlayerNum {
-			result := game.getVotingResult()
+	default:
+		return errors.New("invalid phase")
+	}
 
-			var targetID uint
-			if len(result.targetIDs) > 1 {
-				r := math_rand.Intn(len(result.targetIDs))
-				targetID = result.targetIDs[r]
-			} else {
-				targetID = result.targetIDs[0]
-			}
+	return nil
+}
 
-			if killedPlayer, err := game.getPlayer(targetID); err == nil {
-				killedPlayer.IsDied = true
-			}
+func (game *Game) Divine(playerID uint, targetID uint) (state State, pahseResult PhaseResult, side Side, err error) {
+	game.mu.Lock()
+	defer game.mu.Unlock()
 
-			winner := game.judge()
+	player, err := game.getPlayer(playerID)
+	if err != nil {
+		return
+	}
 
-			if winner != Neutral {
-				game.Phase = End
-				phaseResult = PhaseResult{Winner: winner}
-			} else {
-				game.Phase = Noon
-				phaseResult = PhaseResult{KilledPlayerID: targetID}
-			}
+	if player.Role != Seer {
+		err = errors.New("player is not seer")
+		return
+	}
 
-			game.votings = make(map[uint]uint)
-			game.nextRequests = make(map[uint]bool)
-		}
-	case phase == Noon:
-		if len(game.votings) == game.getAlivePlayerNum() &&
-			len(game.nextRequests) == game.Config.PlayerNum {
-			result := game.getVotingResult()
+	if player.IsDied {
+		err = errors.New("player is already died")
+		return
+	}
 
-			if len(result.targetIDs) == 1 && result.targetIDs[0] > 0 {
-				if killedPlayer, err := game.getPlayer(result.targetIDs[0]); err == nil {
-					killedPlayer.IsDied = true
-				}
+	target, err := game.getPlayer(targetID)
+	if err != nil {
+		return
+	}
 
-				winner := game.judge()
+	if target.IsDied {
+		err = errors.New("target player is already died")
+		return
+	}
 
-				if winner != Neutral {
-					game.Phase = End
-					phaseResult = PhaseResult{Winner: winner}
-				} else {
-					game.Phase = Night
-					game.Day++
-					phaseResult = PhaseResult{KilledPlayerID: result.targetIDs[0]}
-				}
-			} else {
-				game.Phase = Night
-				game.Day++
-			}
+	if game.Phase != Night {
+		err = errors.New("invalid phase")
+		return
+	}
 
-			game.votings = make(map[uint]uint)
-			game.nextRequests = make(map[uint]bool)
-		}
+	if playerID == targetID {
+		err = errors.New("cannot divine myself")
+		return
 	}
 
-	return
-}
+	if _, ok := game.divinations[playerID]; ok {
+		err = errors.New("already divined")
+		return
+	}
 
-func (game *Game) getVotingResult() VotingResult {
-	return linq.From(game.votings).Select(func(i interface{}) interface{} {
-		return i.(linq.KeyValue).Value
-	}).GroupBy(func(i interface{}) interface{} {
-		return i
-	}, func(i interface{}) interface{} {
-		return i
-	}).AggregateWithSeed(VotingResult{targetIDs: []uint{}}, func(r, c interface{}) interface{} {
-		result := r.(VotingResult)
-		group := c.(linq.Group)
-		number := len(group.Group)
+	game.divinations[playerID] = targetID
+	game.nextRequests[playerID] = true
 
-		if number > result.number {
-			result.targetIDs = []uint{group.Key.(uint)}
-			result.number = number
-		} else if number == result.number {
-			result.targetIDs = append(result.targetIDs, group.Key.(uint))
-		}
-		return result
-	}).(VotingResult)
-}
+	side = revealSide(target)
 
-func (game *Game) judge() (winner Side) {
-	villagerNum := linq.From(game.players).CountWith(func(i interface{}) bool {
-		player := i.(linq.KeyValue).Value.(*Player)
-		return player.Side == Villagers && !player.IsDied
-	})
+	oldPhase := game.Phase
 
-	werewolNum := linq.From(game.players).CountWith(func(i interface{}) bool {
-		player := i.(linq.KeyValue).Value.(*Player)
-		return player.Side == Werewolves && !player.IsDied
-	})
+	pahseResult = game.update()
+
+	state = game.snapshot()
+
+	game.notifyPhaseChangedIfNeeded(oldPhase, state, pahseResult)
 
-	winner = Neutral
-	if werewolNum == 0 {
-		winner = Villagers
-	} else if villagerNum <= werewolNum {
-		winner = Werewolves
-	}
 	return
 }
 
-func (game *Game) GetPlayer(playerID uint) (player Player, err error) {
-	game.mu.RLock()
-	defer game.mu.RUnlock()
-	pplayer, err := game.getPlayer(playerID)
-	return *pplayer, err
-}
+func (game *Game) Guard(playerID uint, targetID uint) (state State, pahseResult PhaseResult, err error) {
+	game.mu.Lock()
+	defer game.mu.Unlock()
 
-func (game *Game) getPlayer(playerID uint) (player *Player, err error) {
-	player, ok := game.players[playerID]
-	if !ok {
-		err = errors.New("player not found")
+	player, err := game.getPlayer(playerID)
+	if err != nil {
 		return
 	}
-	return
-}
 
-func (game *Game) ObserveState(playerID uint) <-chan StateChange {
-	actual, _ := game.stateChangeds.LoadOrStore(playerID, make(chan StateChange))
-	return actual.(chan StateChange)
-}
+	if player.Role != Bodyguard {
+		err = errors.New("player is not bodyguard")
+		return
+	}
+
+	if player.IsDied {
+		err = errors.New("player is already died")
+		return
+	}
+
+	target, err := game.getPlayer(targetID)
+	if err != nil {
+		return
+	}
+
+	if target.IsDied {
+		err = errors.New("target player is already died")
+		return
+	}
+
+	if game.Phase != Night || game.Day == 1 {
+		err = errors.New("invalid phase")
+		return
+	}
+
+	if playerID == targetID {
+		err = errors.New("cannot guard myself")
+		return
+	}
+
+	if lastTargetID, ok := game.lastGuards[playerID]; ok &&
+		lastTargetID == targetID && !game.Config.AllowConsecutiveGuard {
+		err = errors.New("cannot guard same player consecutively")
+		return
+	}
+
+	if _, ok := game.guards[playerID]; ok {
+		err = errors.New("already guarded")
+		return
+	}
+
+	game.guards[playerID] = targetID
+	game.nextRequests[playerID] = true
+
+	oldPhase := game.Phase
+
+	pahseResult = game.update()
+
+	state = game.snapshot()
+
+	game.notifyPhaseChangedIfNeeded(oldPhase, state, pahseResult)
+
+	return
+}
+
+func (game *Game) Shoot(playerID uint, targetID uint) (state State, pahseResult PhaseResult, err error) {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+
+	_, err = game.getPlayer(playerID)
+	if err != nil {
+		return
+	}
+
+	if game.Phase != Hunting {
+		err = errors.New("invalid phase")
+		return
+	}
+
+	if playerID != game.hunterIDs[0] {
+		err = errors.New("player is not hunting")
+		return
+	}
+
+	if playerID == targetID {
+		err = errors.New("cannot shoot myself")
+		return
+	}
+
+	if targetID > 0 {
+		var target *Player
+		target, err = game.getPlayer(targetID)
+		if err != nil {
+			return
+		}
+
+		if target.IsDied {
+			err = errors.New("target player is already died")
+			return
+		}
+	}
+
+	game.hunterIDs = game.hunterIDs[1:]
+
+	oldPhase := game.Phase
+
+	pahseResult = game.advance(game.kill(targetID, Shot), game.nextPhase)
+
+	state = game.snapshot()
+
+	game.notifyPhaseChangedIfNeeded(oldPhase, state, pahseResult)
+
+	return
+}
+
+func (game *Game) Bind(playerID uint, firstID uint, secondID uint) (state State, pahseResult PhaseResult, err error) {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+
+	player, err := game.getPlayer(playerID)
+	if err != nil {
+		return
+	}
+
+	if player.Role != Cupid {
+		err = errors.New("player is not cupid")
+		return
+	}
+
+	if player.IsDied {
+		err = errors.New("player is already died")
+		return
+	}
+
+	if game.Phase != Night || game.Day != 1 {
+		err = errors.New("invalid phase")
+		return
+	}
+
+	if firstID == secondID {
+		err = errors.New("cannot bind same player")
+		return
+	}
+
+	first, err := game.getPlayer(firstID)
+	if err != nil {
+		return
+	}
+
+	second, err := game.getPlayer(secondID)
+	if err != nil {
+		return
+	}
+
+	if first.IsDummy || second.IsDummy {
+		err = errors.New("cannot bind scapegoat")
+		return
+	}
+
+	if first.LoverID > 0 || second.LoverID > 0 {
+		err = errors.New("target player is already bound")
+		return
+	}
+
+	if _, ok := game.bindings[playerID]; ok {
+		err = errors.New("already bound")
+		return
+	}
+
+	first.LoverID = secondID
+	second.LoverID = firstID
+
+	game.bindings[playerID] = [2]uint{firstID, secondID}
+	game.nextRequests[playerID] = true
+
+	oldPhase := game.Phase
+
+	pahseResult = game.update()
+
+	state = game.snapshot()
+
+	game.notifyPhaseChangedIfNeeded(oldPhase, state, pahseResult)
+
+	return
+}
+
+func (game *Game) Next(playerID uint) (state State, pahseResult PhaseResult, err error) {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+
+	player, err := game.getPlayer(playerID)
+	if err != nil {
+		return
+	}
+
+	if player.IsForfeited {
+		err = errors.New("player is forfeited")
+		return
+	}
+
+	if game.Phase != Night && game.Phase != Noon {
+		err = errors.New("invalid phase")
+		return
+	}
+
+	if _, ok := game.nextRequests[playerID]; ok {
+		err = errors.New("already requested")
+		return
+	}
+
+	game.nextRequests[playerID] = true
+
+	oldPhase := game.Phase
+
+	pahseResult = game.update()
+
+	state = game.snapshot()
+
+	game.notifyPhaseChangedIfNeeded(oldPhase, state, pahseResult)
+
+	return
+}
+
+func (game *Game) Forfeit(playerID uint) (state State, deaths []Death, pahseResult PhaseResult, err error) {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+
+	player, err := game.getPlayer(playerID)
+	if err != nil {
+		return
+	}
+
+	if player.IsForfeited {
+		err = errors.New("player is already forfeited")
+		return
+	}
+
+	if game.Phase != Night && game.Phase != Noon && game.Phase != Hunting {
+		err = errors.New("invalid phase")
+		return
+	}
+
+	player.IsForfeited = true
+	deaths = game.kill(playerID, Forfeited)
+
+	killsWerewolf := false
+	for _, death := range deaths {
+		delete(game.votings, death.PlayerID)
+		delete(game.lockIns, death.PlayerID)
+		delete(game.divinations, death.PlayerID)
+		delete(game.guards, death.PlayerID)
+
+		if killedPlayer, err := game.getPlayer(death.PlayerID); err == nil && killedPlayer.Role == Werewolf {
+			killsWerewolf = true
+		}
+	}
+	delete(game.nextRequests, playerID)
+
+	if killsWerewolf && game.Phase == Night {
+		game.notifyKillVotesChanged()
+	}
+
+	for i, hunterID := range game.hunterIDs {
+		if hunterID == playerID {
+			game.hunterIDs = append(game.hunterIDs[:i], game.hunterIDs[i+1:]...)
+			break
+		}
+	}
+
+	oldPhase := game.Phase
+
+	pahseResult = game.update()
+
+	if game.Phase == oldPhase {
+		if game.Phase == Hunting && len(game.hunterIDs) == 0 {
+			pahseResult = game.advance(nil, game.nextPhase)
+		} else if winner := game.judge(); winner != Neutral {
+			game.Phase = End
+			game.startTimer()
+			pahseResult = PhaseResult{Winner: winner}
+		}
+	}
+
+	state = game.snapshot()
+
+	game.notify(StateChange{
+		State:             state,
+		ChangeType:        PlayerForfeited,
+		OldPhase:          oldPhase,
+		ForfeitedPlayerID: playerID,
+		Deaths:            deaths,
+	})
+
+	if state.Phase != oldPhase || len(pahseResult.RunoffCandidateIDs) > 0 {
+		game.notifyPhaseChangedIfNeeded(oldPhase, state, pahseResult)
+	}
+
+	return
+}
+
+func (game *Game) GetRoles(playerID uint) (roles map[uint]Role, err error) {
+	game.mu.RLock()
+	defer game.mu.RUnlock()
+
+	if game.spectators[playerID] {
+		if game.Phase == Start {
+			err = errors.New("invalid phase")
+			return
+		}
+
+		roles = map[uint]Role{}
+		if game.Config.SpectatorsSeeRoles {
+			for k, p := range game.players {
+				if !p.IsDummy {
+					roles[k] = p.Role
+				}
+			}
+		}
+		return
+	}
+
+	player, err := game.getPlayer(playerID)
+	if err != nil {
+		return
+	}
+
+	if game.Phase == Start {
+		err = errors.New("invalid phase")
+		return
+	}
+
+	roles = game.getRoles(player)
+
+	return
+}
+
+func (game *Game) getRoles(player *Player) (roles map[uint]Role) {
+	roles = map[uint]Role{player.ID: player.Role}
+
+	if player.Role == Werewolf {
+		for k, p := range game.players {
+			if p.Role == Werewolf {
+				roles[k] = p.Role
+			}
+		}
+	}
+
+	return
+}
+
+func (game *Game) GetLoverID(playerID uint) (loverID uint, err error) {
+	game.mu.RLock()
+	defer game.mu.RUnlock()
+
+	player, err := game.getPlayer(playerID)
+	if err != nil {
+		return
+	}
+
+	if game.Phase == Start {
+		err = errors.New("invalid phase")
+		return
+	}
+
+	loverID = player.LoverID
+
+	return
+}
+
+func (game *Game) GetMediumResult(playerID uint) (executedPlayerID uint, side Side, err error) {
+	game.mu.RLock()
+	defer game.mu.RUnlock()
+
+	player, err := game.getPlayer(playerID)
+	if err != nil {
+		return
+	}
+
+	if player.Role != Medium {
+		err = errors.New("player is not medium")
+		return
+	}
+
+	if player.IsDied {
+		err = errors.New("player is already died")
+		return
+	}
+
+	if game.Phase != Night {
+		err = errors.New("invalid phase")
+		return
+	}
+
+	if game.executedID == 0 {
+		return
+	}
+
+	executedPlayer, err := game.getPlayer(game.executedID)
+	if err != nil {
+		return
+	}
+
+	executedPlayerID = executedPlayer.ID
+	side = revealSide(executedPlayer)
+
+	return
+}
+
+func (game *Game) update() (phaseResult PhaseResult) {
+	phase := game.Phase
+	day := game.Day
+
+	switch {
+	case phase == Start:
+		if len(game.players) == game.Config.PlayerNum {
+			game.Phase = Night
+			game.Day = 1
+
+			if game.Config.Scapegoat {
+				game.players[scapegoatID] = &Player{
+					ID:         scapegoatID,
+					Name:       scapegoatName,
+					IsDummy:    true,
+					JoinedTime: time.Now(),
+				}
+			}
+
+			game.setRoles()
+			game.startTimer()
+
+			for _, p := range game.players {
+				if p.IsDummy {
+					continue
+				}
+				game.notifyPrivate(p.ID, PrivateEvent{
+					Type:  RoleAssigned,
+					Roles: game.getRoles(p),
+				})
+			}
+		}
+	case phase == Night && day == 1:
+		if len(game.divinations) == game.getAlivePlayerNumByRole(Seer) &&
+			len(game.bindings) == game.getAlivePlayerNumByRole(Cupid) &&
+			len(game.nextRequests) == game.getActivePlayerNum() {
+			deaths := game.kill(scapegoatID, Attacked)
+			deaths = append(deaths, game.killDivinedFoxes()...)
+
+			phaseResult = game.advance(deaths, Noon)
+
+			game.divinations = make(map[uint]uint)
+			game.nextRequests = make(map[uint]bool)
+		}
+	case phase == Night:
+		if len(game.lockIns) == game.getAlivePlayerNumByRole(Werewolf) &&
+			len(game.divinations) == game.getAlivePlayerNumByRole(Seer) &&
+			len(game.guards) == game.getAlivePlayerNumByRole(Bodyguard) &&
+			len(game.nextRequests) == game.getActivePlayerNum() {
+			targetID := game.getKillTargetID()
+
+			var deaths []Death
+			if target, err := game.getPlayer(targetID); err == nil &&
+				!game.isGuarded(targetID) && target.Role != Fox {
+				deaths = game.kill(targetID, Attacked)
+			}
+
+			deaths = append(deaths, game.killDivinedFoxes()...)
+
+			phaseResult = game.advance(deaths, Noon)
+
+			game.votings = make(map[uint]uint)
+			game.lockIns = make(map[uint]bool)
+			game.divinations = make(map[uint]uint)
+			game.lastGuards = game.guards
+			game.guards = make(map[uint]uint)
+			game.nextRequests = make(map[uint]bool)
+		}
+	case phase == Noon:
+		if len(game.lockIns) == game.getVoterNum() &&
+			len(game.nextRequests) == game.getActivePlayerNum() {
+			result := game.getVotingResult()
+
+			candidateIDs := []uint{}
+			for _, targetID := range result.targetIDs {
+				if targetID > 0 {
+					candidateIDs = append(candidateIDs, targetID)
+				}
+			}
+			sort.Slice(candidateIDs, func(i, j int) bool {
+				return candidateIDs[i] < candidateIDs[j]
+			})
+
+			var votes map[uint]uint
+			if game.Config.OpenBallots {
+				votes = game.votings
+			}
+
+			game.votings = make(map[uint]uint)
+			game.lockIns = make(map[uint]bool)
+			game.nextRequests = make(map[uint]bool)
+
+			if len(game.runoffCandidateIDs) == 0 && len(candidateIDs) > 1 {
+				game.runoffCandidateIDs = candidateIDs
+				game.startTimer()
+				phaseResult = PhaseResult{RunoffCandidateIDs: candidateIDs, Votes: votes}
+				return
+			}
+
+			var executedID uint
+			if len(result.targetIDs) == 1 {
+				executedID = result.targetIDs[0]
+			} else if len(game.runoffCandidateIDs) > 0 && game.Config.ExecutionTieBreak == ExecuteRandomly {
+				if len(candidateIDs) == 0 {
+					candidateIDs = game.runoffCandidateIDs
+				}
+				executedID = candidateIDs[game.random.Intn(len(candidateIDs))]
+			}
+
+			game.executedID = 0
+			game.runoffCandidateIDs = nil
+
+			var deaths []Death
+			if target, err := game.getPlayer(executedID); err == nil && !target.IsDied {
+				deaths = game.kill(executedID, Executed)
+				game.executedID = executedID
+			}
+
+			phaseResult = game.advance(deaths, Night)
+			phaseResult.Votes = votes
+		}
+	}
+
+	return
+}
+
+func (game *Game) advance(deaths []Death, nextPhase Phase) (phaseResult PhaseResult) {
+	defer game.startTimer()
+
+	phaseResult = PhaseResult{Deaths: deaths}
+
+	for _, death := range deaths {
+		if killedPlayer, err := game.getPlayer(death.PlayerID); err == nil &&
+			killedPlayer.Role == Hunter && !killedPlayer.IsDummy {
+			game.hunterIDs = append(game.hunterIDs, death.PlayerID)
+		}
+	}
+
+	if len(game.hunterIDs) > 0 {
+		game.Phase = Hunting
+		game.nextPhase = nextPhase
+		return
+	}
+
+	winner := game.judge()
+
+	if winner != Neutral {
+		game.Phase = End
+		phaseResult.Winner = winner
+		return
+	}
+
+	if nextPhase == Night {
+		game.Day++
+	}
+	game.Phase = nextPhase
+
+	return
+}
+
+func (game *Game) SetTimeoutHandler(handler func(state State)) {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	game.onTimeout = handler
+}
+
+func (game *Game) startTimer() {
+	if game.timer != nil {
+		game.timer.Stop()
+		game.timer = nil
+	}
+
+	var duration time.Duration
+	switch game.Phase {
+	case Noon:
+		if len(game.runoffCandidateIDs) > 0 {
+			duration = game.Config.VotingTime
+		} else {
+			duration = game.Config.DiscussionTime + game.Config.VotingTime
+		}
+	case Night:
+		duration = game.Config.NightTime
+	case Hunting:
+		duration = game.Config.VotingTime
+	}
+
+	if duration <= 0 {
+		game.deadline = time.Time{}
+		return
+	}
+
+	deadline := time.Now().Add(duration)
+	game.deadline = deadline
+	game.timer = time.AfterFunc(duration, func() {
+		game.timeout(deadline)
+	})
+}
+
+func (game *Game) timeout(deadline time.Time) {
+	game.mu.Lock()
+
+	if !game.deadline.Equal(deadline) {
+		game.mu.Unlock()
+		return
+	}
+
+	oldPhase := game.Phase
+	phaseResult := game.forceResolve()
+	state := game.snapshot()
+	game.notifyPhaseChangedIfNeeded(oldPhase, state, phaseResult)
+	onTimeout := game.onTimeout
+
+	game.mu.Unlock()
+
+	if onTimeout != nil {
+		onTimeout(state)
+	}
+}
+
+func (game *Game) forceResolve() (phaseResult PhaseResult) {
+	switch game.Phase {
+	case Noon:
+		for id, player := range game.players {
+			if !game.canVote(player) {
+				continue
+			}
+			if _, ok := game.votings[id]; !ok {
+				game.votings[id] = 0
+			}
+			game.lockIns[id] = true
+		}
+	case Night:
+		var randomTargetID uint
+		if game.Config.RandomKillOnTimeout && game.Day > 1 && len(game.votings) == 0 {
+			randomTargetID = game.getRandomKillTargetID()
+		}
+
+		for id, player := range game.players {
+			if player.IsDied || player.IsDummy {
+				continue
+			}
+
+			switch player.Role {
+			case Werewolf:
+				if randomTargetID > 0 {
+					game.votings[id] = randomTargetID
+				}
+				if game.Day > 1 {
+					game.lockIns[id] = true
+				}
+			case Seer:
+				if _, ok := game.divinations[id]; !ok {
+					game.divinations[id] = 0
+				}
+			case Bodyguard:
+				if _, ok := game.guards[id]; !ok && game.Day > 1 {
+					game.guards[id] = 0
+				}
+			case Cupid:
+				if _, ok := game.bindings[id]; !ok && game.Day == 1 {
+					game.bindings[id] = [2]uint{}
+				}
+			}
+		}
+	case Hunting:
+		game.hunterIDs = game.hunterIDs[1:]
+		phaseResult = game.advance(nil, game.nextPhase)
+		return
+	default:
+		return
+	}
+
+	for id, player := range game.players {
+		if !player.IsForfeited && !player.IsDummy {
+			game.nextRequests[id] = true
+		}
+	}
+
+	phaseResult = game.update()
+
+	return
+}
+
+func (game *Game) getKillTargetID() uint {
+	result := game.getVotingResult()
+
+	if len(result.targetIDs) == 0 {
+		return 0
+	}
+
+	if len(result.targetIDs) == 1 {
+		return result.targetIDs[0]
+	}
+
+	switch game.Config.KillTieBreak {
+	case KillNobody:
+		return 0
+	case KillByLeadWolf:
+		if leadWolf := game.getLeadWolf(); leadWolf != nil {
+			if targetID, ok := game.votings[leadWolf.ID]; ok {
+				for _, tiedID := range result.targetIDs {
+					if tiedID == targetID {
+						return targetID
+					}
+				}
+			}
+		}
+	}
+
+	return result.targetIDs[game.random.Intn(len(result.targetIDs))]
+}
+
+func (game *Game) getLeadWolf() (leadWolf *Player) {
+	for _, player := range game.players {
+		if player.Role == Werewolf && !player.IsDied && !player.IsForfeited &&
+			(leadWolf == nil || player.Index < leadWolf.Index) {
+			leadWolf = player
+		}
+	}
+	return
+}
+
+func (game *Game) getRandomKillTargetID() uint {
+	targetIDs := []uint{}
+	for id, player := range game.players {
+		if !player.IsDied && player.Role != Werewolf {
+			targetIDs = append(targetIDs, id)
+		}
+	}
+
+	if len(targetIDs) == 0 {
+		return 0
+	}
+
+	sort.Slice(targetIDs, func(i, j int) bool {
+		return targetIDs[i] < targetIDs[j]
+	})
+
+	return targetIDs[game.random.Intn(len(targetIDs))]
+}
+
+func (game *Game) kill(playerID uint, cause DeathCause) (deaths []Death) {
+	player, err := game.getPlayer(playerID)
+	if err != nil || player.IsDied {
+		return
+	}
+
+	player.IsDied = true
+	deaths = append(deaths, Death{PlayerID: player.ID, Cause: cause})
+
+	if player.LoverID > 0 {
+		deaths = append(deaths, game.kill(player.LoverID, Suicide)...)
+	}
+
+	return
+}
+
+func (game *Game) killDivinedFoxes() (deaths []Death) {
+	for _, targetID := range game.divinations {
+		if target, err := game.getPlayer(targetID); err == nil && target.Role == Fox {
+			deaths = append(deaths, game.kill(targetID, Cursed)...)
+		}
+	}
+	return
+}
+
+func (game *Game) isGuarded(playerID uint) bool {
+	for _, targetID := range game.guards {
+		if targetID == playerID {
+			return true
+		}
+	}
+	return false
+}
+
+func (game *Game) getVotingResult() (result VotingResult) {
+	result = linq.From(game.votings).Select(func(i interface{}) interface{} {
+		return i.(linq.KeyValue).Value
+	}).GroupBy(func(i interface{}) interface{} {
+		return i
+	}, func(i interface{}) interface{} {
+		return i
+	}).AggregateWithSeed(VotingResult{targetIDs: []uint{}}, func(r, c interface{}) interface{} {
+		result := r.(VotingResult)
+		group := c.(linq.Group)
+		number := len(group.Group)
+
+		if number > result.number {
+			result.targetIDs = []uint{group.Key.(uint)}
+			result.number = number
+		} else if number == result.number {
+			result.targetIDs = append(result.targetIDs, group.Key.(uint))
+		}
+		return result
+	}).(VotingResult)
+
+	sort.Slice(result.targetIDs, func(i, j int) bool {
+		return result.targetIDs[i] < result.targetIDs[j]
+	})
+
+	return
+}
+
+func (game *Game) judge() (winner Side) {
+	humanNum := linq.From(game.players).CountWith(func(i interface{}) bool {
+		player := i.(linq.KeyValue).Value.(*Player)
+		return player.Role.Species() == Human && !player.IsDied
+	})
+
+	werewolNum := linq.From(game.players).CountWith(func(i interface{}) bool {
+		player := i.(linq.KeyValue).Value.(*Player)
+		return player.Role.Species() == Wolf && !player.IsDied
+	})
+
+	foxNum := game.getAlivePlayerNumByRole(Fox)
+
+	if game.getAlivePlayerNum() == 2 {
+		for _, player := range game.players {
+			if lover, err := game.getPlayer(player.LoverID); err == nil &&
+				!player.IsDied && !lover.IsDied {
+				winner = Lovers
+				return
+			}
+		}
+	}
+
+	winner = Neutral
+	if werewolNum == 0 {
+		winner = Villagers
+	} else if humanNum <= werewolNum {
+		winner = Werewolves
+	}
+
+	if winner != Neutral && foxNum > 0 {
+		winner = Foxes
+	}
+	return
+}
+
+func revealSide(player *Player) Side {
+	if player.Role.Species() == Wolf {
+		return Werewolves
+	}
+	return Villagers
+}
+
+func (game *Game) GetPlayer(playerID uint) (player Player, err error) {
+	game.mu.RLock()
+	defer game.mu.RUnlock()
+	pplayer, err := game.getPlayer(playerID)
+	return *pplayer, err
+}
+
+func (game *Game) getPlayer(playerID uint) (player *Player, err error) {
+	player, ok := game.players[playerID]
+	if !ok {
+		err = errors.New("player not found")
+		return
+	}
+	return
+}
+
+func (game *Game) ObserveState(playerID uint, lastSeenSequence uint64) (backlog []StateChange, observer *StateObserver, err error) {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+
+	if _, ok := game.players[playerID]; !ok && !game.spectators[playerID] {
+		err = errors.New("player not found")
+		return
+	}
+
+	if lastSeenSequence > 0 && len(game.history) > 0 &&
+		lastSeenSequence+1 < game.history[0].Sequence {
+		err = ErrHistoryExpired
+		return
+	}
+
+	backlog = []StateChange{}
+	for _, stateChange := range game.history {
+		if stateChange.Sequence > lastSeenSequence {
+			backlog = append(backlog, stateChange)
+		}
+	}
+
+	game.unobserveState(playerID)
+
+	observer = &StateObserver{ch: make(chan StateChange, observerBufferSize)}
+	if game.Phase == End {
+		close(observer.ch)
+	} else {
+		game.stateChangeds[playerID] = observer
+	}
+
+	return
+}
 
 func (game *Game) UnobserveState(playerID uint) {
-	value, loaded := game.stateChangeds.LoadAndDelete(playerID)
-	if loaded {
-		close(value.(chan StateChange))
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	game.unobserveState(playerID)
+}
+
+func (game *Game) unobserveState(playerID uint) {
+	if observer, ok := game.stateChangeds[playerID]; ok {
+		delete(game.stateChangeds, playerID)
+		close(observer.ch)
 	}
 }
 
-func (game *Game) NotifyStateChanged(stateChange StateChange) {
-	game.stateChangeds.Range(func(key, value interface{}) bool {
-		stateChanged := value.(chan StateChange)
-		stateChanged <- stateChange
-		return true
+func (game *Game) dropStateObserver(playerID uint) {
+	if observer, ok := game.stateChangeds[playerID]; ok {
+		observer.err = ErrObserverDropped
+		game.unobserveState(playerID)
+	}
+}
+
+// notify must be called with game.mu held so that every observer receives
+// changes in the order they were made. An observer whose queue is full is
+// dropped with ErrObserverDropped instead of blocking the game, and has to
+// observe again with the last sequence it received to get the rest replayed.
+func (game *Game) notify(stateChange StateChange) {
+	game.sequence++
+	stateChange.Sequence = game.sequence
+
+	game.history = append(game.history, stateChange)
+	if len(game.history) > stateHistorySize {
+		game.history = game.history[len(game.history)-stateHistorySize:]
+	}
+
+	for playerID, observer := range game.stateChangeds {
+		select {
+		case observer.ch <- stateChange:
+		default:
+			game.dropStateObserver(playerID)
+		}
+	}
+}
+
+// publicDeaths hides curses so that observers cannot tell a divined fox from
+// an ordinary victim of the werewolves.
+func publicDeaths(deaths []Death) []Death {
+	public := make([]Death, len(deaths))
+	for i, death := range deaths {
+		if death.Cause == Cursed {
+			death.Cause = Attacked
+		}
+		public[i] = death
+	}
+	return public
+}
+
+func (game *Game) notifyPhaseChangedIfNeeded(oldPhase Phase, state State, phaseResult PhaseResult) {
+	if len(phaseResult.RunoffCandidateIDs) > 0 {
+		game.notify(StateChange{
+			State:      state,
+			ChangeType: RunoffStarted,
+			OldPhase:   oldPhase,
+			Votes:      phaseResult.Votes,
+		})
+		return
+	}
+
+	if state.Phase == oldPhase && oldPhase != Hunting {
+		return
+	}
+
+	stateChange := StateChange{
+		State:    state,
+		OldPhase: oldPhase,
+		Votes:    phaseResult.Votes,
+	}
+
+	if phaseResult.Winner != Neutral {
+		stateChange.ChangeType = GameOver
+		stateChange.Winner = phaseResult.Winner
+		stateChange.Deaths = publicDeaths(phaseResult.Deaths)
+	} else if oldPhase == Hunting {
+		stateChange.ChangeType = PlayerShot
+		stateChange.Deaths = publicDeaths(phaseResult.Deaths)
+	} else if len(phaseResult.Deaths) > 0 {
+		stateChange.ChangeType = PhaseChanged
+		stateChange.Deaths = publicDeaths(phaseResult.Deaths)
+	} else {
+		stateChange.ChangeType = PhaseChangedWithoutKilling
+	}
+
+	game.notify(stateChange)
+}
+
+func (game *Game) SendMessage(playerID uint, text string) (message Message, err error) {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+
+	player, err := game.getPlayer(playerID)
+	if err != nil {
+		return
+	}
+
+	if text == "" || utf8.RuneCountInString(text) > maxMessageLength {
+		err = errors.New("invalid message")
+		return
+	}
+
+	var channel Channel
+	switch {
+	case player.IsDied:
+		channel = GraveyardChannel
+	case game.Phase == Start || game.Phase == Noon:
+		channel = PublicChannel
+	case game.Phase == Night && player.Role == Werewolf:
+		channel = WerewolfChannel
+	default:
+		err = errors.New("cannot send message")
+		return
+	}
+
+	message = Message{
+		ID:       uint(len(game.messages) + 1),
+		PlayerID: playerID,
+		Channel:  channel,
+		Text:     text,
+		Phase:    game.Phase,
+		Day:      game.Day,
+		SentTime: time.Now(),
+	}
+
+	game.messages = append(game.messages, message)
+
+	for id, observer := range game.messageds {
+		if p, ok := game.players[id]; !ok || !canRead(p, message) {
+			continue
+		}
+
+		select {
+		case observer.ch <- message:
+		default:
+			game.dropMessageObserver(id)
+		}
+	}
+
+	return
+}
+
+func (game *Game) ObserveMessages(playerID uint) (backlog []Message, observer *MessageObserver, err error) {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+
+	player, err := game.getPlayer(playerID)
+	if err != nil {
+		return
+	}
+
+	backlog = []Message{}
+	for _, message := range game.messages {
+		if canRead(player, message) {
+			backlog = append(backlog, message)
+		}
+	}
+
+	game.unobserveMessages(playerID)
+
+	observer = &MessageObserver{ch: make(chan Message, observerBufferSize)}
+	if game.Phase == End {
+		close(observer.ch)
+	} else {
+		game.messageds[playerID] = observer
+	}
+
+	return
+}
+
+func (game *Game) UnobserveMessages(playerID uint) {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	game.unobserveMessages(playerID)
+}
+
+func (game *Game) unobserveMessages(playerID uint) {
+	if observer, ok := game.messageds[playerID]; ok {
+		delete(game.messageds, playerID)
+		close(observer.ch)
+	}
+}
+
+func (game *Game) dropMessageObserver(playerID uint) {
+	if observer, ok := game.messageds[playerID]; ok {
+		observer.err = ErrObserverDropped
+		game.unobserveMessages(playerID)
+	}
+}
+
+func (game *Game) ObservePrivateEvents(playerID uint) (backlog []PrivateEvent, observer *PrivateEventObserver, err error) {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+
+	if _, err = game.getPlayer(playerID); err != nil {
+		return
+	}
+
+	backlog = append([]PrivateEvent{}, game.privateEvents[playerID]...)
+
+	game.unobservePrivateEvents(playerID)
+
+	observer = &PrivateEventObserver{ch: make(chan PrivateEvent, observerBufferSize)}
+	if game.Phase == End {
+		close(observer.ch)
+	} else {
+		game.privateEventeds[playerID] = observer
+	}
+
+	return
+}
+
+func (game *Game) UnobservePrivateEvents(playerID uint) {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	game.unobservePrivateEvents(playerID)
+}
+
+func (game *Game) unobservePrivateEvents(playerID uint) {
+	if observer, ok := game.privateEventeds[playerID]; ok {
+		delete(game.privateEventeds, playerID)
+		close(observer.ch)
+	}
+}
+
+func (game *Game) dropPrivateEventObserver(playerID uint) {
+	if observer, ok := game.privateEventeds[playerID]; ok {
+		observer.err = ErrObserverDropped
+		game.unobservePrivateEvents(playerID)
+	}
+}
+
+func (game *Game) notifyKillVotesChanged() {
+	votes := make(map[uint]uint)
+	for id, targetID := range game.votings {
+		votes[id] = targetID
+	}
+
+	lockedPlayerIDs := []uint{}
+	for id := range game.lockIns {
+		lockedPlayerIDs = append(lockedPlayerIDs, id)
+	}
+	sort.Slice(lockedPlayerIDs, func(i, j int) bool {
+		return lockedPlayerIDs[i] < lockedPlayerIDs[j]
 	})
+
+	for _, player := range game.players {
+		if player.Role == Werewolf && !player.IsDied {
+			game.notifyPrivate(player.ID, PrivateEvent{
+				Type:            KillVotesChanged,
+				Votes:           votes,
+				LockedPlayerIDs: lockedPlayerIDs,
+			})
+		}
+	}
+}
+
+func (game *Game) notifyPrivate(playerID uint, privateEvent PrivateEvent) {
+	privateEvent.ID = uint(len(game.privateEvents[playerID]) + 1)
+	privateEvent.Phase = game.Phase
+	privateEvent.Day = game.Day
+
+	game.privateEvents[playerID] = append(game.privateEvents[playerID], privateEvent)
+
+	if observer, ok := game.privateEventeds[playerID]; ok {
+		select {
+		case observer.ch <- privateEvent:
+		default:
+			game.dropPrivateEventObserver(playerID)
+		}
+	}
+}
+
+func canRead(player *Player, message Message) bool {
+	switch message.Channel {
+	case PublicChannel:
+		return true
+	case WerewolfChannel:
+		return player.Role == Werewolf
+	case GraveyardChannel:
+		return player.IsDied
+	default:
+		return false
+	}
 }
 
 func (game *Game) Snapshot() State {
@@ -453,19 +1820,68 @@ func (game *Game) snapshot() State {
 		players[k] = *p
 	}
 	return State{
-		ID:      game.ID,
-		Config:  game.Config,
-		Phase:   game.Phase,
-		Day:     game.Day,
-		Players: players,
+		ID:                 game.ID,
+		Config:             game.Config,
+		Phase:              game.Phase,
+		Day:                game.Day,
+		OwnerID:            game.OwnerID,
+		Players:            players,
+		Deadline:           game.deadline,
+		RunoffCandidateIDs: append([]uint{}, game.runoffCandidateIDs...),
 	}
 }
 
 func (game *Game) Dispose() {
-	game.stateChangeds.Range(func(key, value interface{}) bool {
-		close(value.(chan StateChange))
-		return true
-	})
+	game.mu.Lock()
+	if game.timer != nil {
+		game.timer.Stop()
+		game.timer = nil
+	}
+	game.deadline = time.Time{}
+
+	for playerID := range game.stateChangeds {
+		game.unobserveState(playerID)
+	}
+
+	for playerID := range game.messageds {
+		game.unobserveMessages(playerID)
+	}
+
+	for playerID := range game.privateEventeds {
+		game.unobservePrivateEvents(playerID)
+	}
+
+	game.mu.Unlock()
+}
+
+func (game *Game) canVote(player *Player) bool {
+	if player.IsDied || player.IsDummy {
+		return false
+	}
+
+	if game.Config.RunoffVoters == NonCandidatesVote && game.isRunoffCandidate(player.ID) {
+		return false
+	}
+
+	return true
+}
+
+func (game *Game) isRunoffCandidate(playerID uint) bool {
+	for _, candidateID := range game.runoffCandidateIDs {
+		if candidateID == playerID {
+			return true
+		}
+	}
+	return false
+}
+
+func (game *Game) getVoterNum() (num int) {
+	for _, player := range game.players {
+		if game.canVote(player) {
+			num++
+		}
+	}
+	return
 }
 
 func (game *Game) getAlivePlayerNum() (num int) {
@@ -477,6 +1893,24 @@ func (game *Game) getAlivePlayerNum() (num int) {
 	return
 }
 
+func (game *Game) getActivePlayerNum() (num int) {
+	for _, player := range game.players {
+		if !player.IsForfeited && !player.IsDummy {
+			num++
+		}
+	}
+	return
+}
+
+func (game *Game) getAlivePlayerNumByRole(role Role) (num int) {
+	for _, player := range game.players {
+		if player.Role == role && !player.IsDied && !player.IsDummy {
+			num++
+		}
+	}
+	return
+}
+
 func generateGameID() (id string, err error) {
 	n, err := rand.Int(rand.Reader, big.NewInt(999999))
 	if err != nil {
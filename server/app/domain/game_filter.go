@@ -0,0 +1,20 @@
+package domain
+
+type GameFilter struct {
+	OpenOnly bool
+	RoleNums map[Role]int
+}
+
+func (filter GameFilter) Match(state State) bool {
+	if filter.OpenOnly && len(state.Players) >= state.Config.PlayerNum {
+		return false
+	}
+
+	for role, num := range filter.RoleNums {
+		if state.Config.RoleNums[role] != num {
+			return false
+		}
+	}
+
+	return true
+}
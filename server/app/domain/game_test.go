@@ -0,0 +1,784 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func newTestGame(t *testing.T, config Config) *Game {
+	game, err := NewGame(config, NewSeededRandom(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(game.Dispose)
+	return game
+}
+
+func TestNotifyDropsStalledObserver(t *testing.T) {
+	game := newTestGame(t, Config{PlayerNum: 5, RoleNums: map[Role]int{Werewolf: 1, Villager: 4}})
+
+	if _, err := game.Join(1, "owner"); err != nil {
+		t.Fatal(err)
+	}
+
+	_, stalled, err := game.ObserveState(1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < observerBufferSize; i++ {
+			if _, err := game.Join(2, "guest"); err != nil {
+				t.Error(err)
+				return
+			}
+			if _, err := game.Leave(2); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("game blocked on stalled observer")
+	}
+
+	received := 0
+	for range stalled.C() {
+		received++
+	}
+
+	if received != observerBufferSize {
+		t.Errorf("received = %d, want %d", received, observerBufferSize)
+	}
+
+	if stalled.Err() != ErrObserverDropped {
+		t.Errorf("Err() = %v, want %v", stalled.Err(), ErrObserverDropped)
+	}
+}
+
+func TestNotifyKeepsOrder(t *testing.T) {
+	game := newTestGame(t, Config{PlayerNum: 5, RoleNums: map[Role]int{Werewolf: 1, Villager: 4}})
+
+	if _, err := game.Join(1, "owner"); err != nil {
+		t.Fatal(err)
+	}
+
+	_, observer, err := game.ObserveState(1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < observerBufferSize/2; i++ {
+		game.Join(2, "guest")
+		game.Leave(2)
+	}
+
+	game.UnobserveState(1)
+
+	var lastSequence uint64
+	i := 0
+	for stateChange := range observer.C() {
+		if stateChange.Sequence <= lastSequence {
+			t.Fatalf("sequence %d after %d", stateChange.Sequence, lastSequence)
+		}
+		lastSequence = stateChange.Sequence
+
+		want := PlayerJoined
+		if i%2 == 1 {
+			want = PlayerLeft
+		}
+		if stateChange.ChangeType != want {
+			t.Fatalf("change %d = %v, want %v", i, stateChange.ChangeType, want)
+		}
+		i++
+	}
+
+	if observer.Err() != nil {
+		t.Errorf("Err() = %v, want nil", observer.Err())
+	}
+}
+
+func TestObserveStateRejectsExpiredHistory(t *testing.T) {
+	game := newTestGame(t, Config{PlayerNum: 5, RoleNums: map[Role]int{Werewolf: 1, Villager: 4}})
+
+	if _, err := game.Join(1, "owner"); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < stateHistorySize; i++ {
+		game.Join(2, "guest")
+		game.Leave(2)
+	}
+
+	if _, _, err := game.ObserveState(1, 1); err != ErrHistoryExpired {
+		t.Errorf("err = %v, want %v", err, ErrHistoryExpired)
+	}
+
+	backlog, _, err := game.ObserveState(1, game.history[0].Sequence-1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backlog) != stateHistorySize {
+		t.Errorf("len(backlog) = %d, want %d", len(backlog), stateHistorySize)
+	}
+}
+
+func playToKillTie(t *testing.T, seed int64) (roles map[uint]Role, victimID uint) {
+	game, err := NewGame(Config{PlayerNum: 7, RoleNums: map[Role]int{Werewolf: 2, Villager: 5}}, NewSeededRandom(seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer game.Dispose()
+
+	for id := uint(1); id <= 7; id++ {
+		if _, err := game.Join(id, "player"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if game.Seed != seed {
+		t.Fatalf("Seed = %d, want %d", game.Seed, seed)
+	}
+
+	roles = make(map[uint]Role)
+	wolfIDs := []uint{}
+	villagerIDs := []uint{}
+	for id := uint(1); id <= 7; id++ {
+		roles[id] = game.players[id].Role
+		if roles[id] == Werewolf {
+			wolfIDs = append(wolfIDs, id)
+		} else {
+			villagerIDs = append(villagerIDs, id)
+		}
+	}
+
+	for id := uint(1); id <= 7; id++ {
+		if _, _, err := game.Next(id); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	executedID := villagerIDs[0]
+	for id := uint(1); id <= 7; id++ {
+		targetID := executedID
+		if id == executedID {
+			targetID = villagerIDs[1]
+		}
+		if _, _, err := game.Vote(id, targetID); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := game.LockIn(id); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if game.Phase != Night {
+		t.Fatalf("Phase = %v, want %v", game.Phase, Night)
+	}
+
+	for i, wolfID := range wolfIDs {
+		if _, _, err := game.Kill(wolfID, villagerIDs[i+1]); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := game.LockIn(wolfID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, id := range villagerIDs {
+		if _, _, err := game.Next(id); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if game.Phase != Noon {
+		t.Fatalf("Phase = %v, want %v", game.Phase, Noon)
+	}
+
+	for _, id := range villagerIDs[1:3] {
+		if game.players[id].IsDied {
+			victimID = id
+		}
+	}
+
+	return
+}
+
+func TestSeededRandomIsDeterministic(t *testing.T) {
+	wantRoles, wantVictimID := playToKillTie(t, 42)
+	if wantVictimID == 0 {
+		t.Fatal("nobody was killed on a tie")
+	}
+
+	for i := 0; i < 50; i++ {
+		roles, victimID := playToKillTie(t, 42)
+
+		for id, role := range wantRoles {
+			if roles[id] != role {
+				t.Fatalf("run %d: role of %d = %v, want %v", i, id, roles[id], role)
+			}
+		}
+
+		if victimID != wantVictimID {
+			t.Fatalf("run %d: victim = %d, want %d", i, victimID, wantVictimID)
+		}
+	}
+}
+
+func TestForceResolveKeepsPartialKillVotes(t *testing.T) {
+	for i := 0; i < 20; i++ {
+		game, err := NewGame(Config{PlayerNum: 7, RoleNums: map[Role]int{Werewolf: 2, Villager: 5}}, NewSeededRandom(int64(i)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer game.Dispose()
+
+		for id := uint(1); id <= 7; id++ {
+			game.Join(id, "player")
+		}
+
+		game.mu.Lock()
+		game.forceResolve()
+		wolfIDs := []uint{}
+		villagerIDs := []uint{}
+		for id := uint(1); id <= 7; id++ {
+			if game.players[id].Role == Werewolf {
+				wolfIDs = append(wolfIDs, id)
+			} else {
+				villagerIDs = append(villagerIDs, id)
+			}
+		}
+		game.forceResolve()
+		game.mu.Unlock()
+
+		if _, _, err := game.Kill(wolfIDs[0], villagerIDs[0]); err != nil {
+			t.Fatal(err)
+		}
+
+		game.mu.Lock()
+		game.forceResolve()
+		game.mu.Unlock()
+
+		if !game.players[villagerIDs[0]].IsDied {
+			t.Fatalf("run %d: the only kill vote was discarded", i)
+		}
+	}
+}
+
+func TestCursedFoxIsReportedAsAttacked(t *testing.T) {
+	game := newTestGame(t, Config{PlayerNum: 5, RoleNums: map[Role]int{Werewolf: 1, Seer: 1, Fox: 1, Villager: 2}})
+
+	for id := uint(1); id <= 5; id++ {
+		game.Join(id, "player")
+	}
+
+	_, observer, err := game.ObserveState(1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var seerID, foxID uint
+	for id, player := range game.players {
+		switch player.Role {
+		case Seer:
+			seerID = id
+		case Fox:
+			foxID = id
+		}
+	}
+
+	if _, _, _, err := game.Divine(seerID, foxID); err != nil {
+		t.Fatal(err)
+	}
+	for id := uint(1); id <= 5; id++ {
+		if id != seerID {
+			game.Next(id)
+		}
+	}
+
+	if !game.players[foxID].IsDied {
+		t.Fatal("divined fox survived")
+	}
+
+	game.UnobserveState(1)
+
+	reported := false
+	for stateChange := range observer.C() {
+		for _, death := range stateChange.Deaths {
+			reported = true
+			if death.Cause != Attacked {
+				t.Errorf("cause of %d = %v, want %v", death.PlayerID, death.Cause, Attacked)
+			}
+		}
+	}
+
+	if !reported {
+		t.Error("death was not reported")
+	}
+}
+
+func TestScapegoatIsOnVillagersSide(t *testing.T) {
+	config := Config{
+		PlayerNum: 4,
+		Scapegoat: true,
+		RoleNums:  map[Role]int{Werewolf: 1, Madman: 1, Fox: 1, Seer: 1, Villager: 1},
+	}
+
+	for seed := int64(0); seed < 50; seed++ {
+		game, err := NewGame(config, NewSeededRandom(seed))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for id := uint(1); id <= 4; id++ {
+			game.Join(id, "player")
+		}
+
+		if role := game.players[scapegoatID].Role; role.Side() != Villagers {
+			t.Fatalf("seed %d: scapegoat role = %v", seed, role)
+		}
+
+		game.Dispose()
+	}
+}
+
+func TestNewGameRejectsUnknownRole(t *testing.T) {
+	config := Config{PlayerNum: 4, RoleNums: map[Role]int{Werewolf: 1, Villager: 2, Role(42): 1}}
+
+	if _, err := NewGame(config, NewSeededRandom(1)); err == nil {
+		t.Error("NewGame accepted an unknown role")
+	}
+}
+
+func TestObserveAfterEndReturnsClosedChannels(t *testing.T) {
+	game := newTestGame(t, Config{PlayerNum: 3, RoleNums: map[Role]int{Werewolf: 1, Villager: 2}})
+
+	for id := uint(1); id <= 3; id++ {
+		game.Join(id, "player")
+	}
+
+	game.mu.Lock()
+	game.Phase = End
+	game.mu.Unlock()
+	game.Dispose()
+
+	_, messages, err := game.ObserveMessages(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case _, ok := <-messages.C():
+		if ok {
+			t.Error("message channel received a value")
+		}
+	case <-time.After(time.Second):
+		t.Error("message channel is still open")
+	}
+
+	_, privateEvents, err := game.ObservePrivateEvents(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case _, ok := <-privateEvents.C():
+		if ok {
+			t.Error("private event channel received a value")
+		}
+	case <-time.After(time.Second):
+		t.Error("private event channel is still open")
+	}
+}
+
+func TestLeadWolfOnlyBreaksTiesAmongTiedTargets(t *testing.T) {
+	game := newTestGame(t, Config{PlayerNum: 9, KillTieBreak: KillByLeadWolf, RoleNums: map[Role]int{Werewolf: 3, Villager: 6}})
+
+	for id := uint(1); id <= 9; id++ {
+		game.Join(id, "player")
+	}
+
+	game.mu.Lock()
+	defer game.mu.Unlock()
+
+	leadWolf := game.getLeadWolf()
+	wolfIDs := []uint{}
+	for id, player := range game.players {
+		if player.Role == Werewolf && id != leadWolf.ID {
+			wolfIDs = append(wolfIDs, id)
+		}
+	}
+
+	game.votings = map[uint]uint{leadWolf.ID: 100, wolfIDs[0]: 101, wolfIDs[1]: 102}
+	if targetID := game.getKillTargetID(); targetID != 100 {
+		t.Errorf("target = %d, want lead wolf's choice 100", targetID)
+	}
+
+	game.votings = map[uint]uint{wolfIDs[0]: 101, wolfIDs[1]: 102}
+	if targetID := game.getKillTargetID(); targetID != 101 && targetID != 102 {
+		t.Errorf("target = %d, want one of the tied targets", targetID)
+	}
+}
+
+func TestKillVotesAreNotSentToDeadWerewolves(t *testing.T) {
+	game := newTestGame(t, Config{PlayerNum: 7, RoleNums: map[Role]int{Werewolf: 2, Villager: 5}})
+
+	for id := uint(1); id <= 7; id++ {
+		game.Join(id, "player")
+	}
+
+	game.mu.Lock()
+	var deadWolf, liveWolf *Player
+	for _, player := range game.players {
+		if player.Role == Werewolf {
+			if deadWolf == nil {
+				deadWolf = player
+			} else {
+				liveWolf = player
+			}
+		}
+	}
+	deadWolf.IsDied = true
+	game.Day = 2
+	game.mu.Unlock()
+
+	_, deadEvents, _ := game.ObservePrivateEvents(deadWolf.ID)
+	_, liveEvents, _ := game.ObservePrivateEvents(liveWolf.ID)
+
+	var targetID uint
+	for id := uint(1); id <= 7; id++ {
+		if game.players[id].Role != Werewolf {
+			targetID = id
+			break
+		}
+	}
+
+	if _, _, err := game.Kill(liveWolf.ID, targetID); err != nil {
+		t.Fatal(err)
+	}
+
+	if privateEvent := <-liveEvents.C(); privateEvent.Type != KillVotesChanged {
+		t.Errorf("live werewolf got %v, want %v", privateEvent.Type, KillVotesChanged)
+	}
+
+	select {
+	case privateEvent := <-deadEvents.C():
+		t.Errorf("dead werewolf got %v", privateEvent.Type)
+	default:
+	}
+}
+
+func startLoversGame(t *testing.T) (game *Game, wolfIDs []uint, villagerIDs []uint) {
+	game = newTestGame(t, Config{PlayerNum: 7, RoleNums: map[Role]int{Werewolf: 2, Villager: 5}})
+
+	for id := uint(1); id <= 7; id++ {
+		if _, err := game.Join(id, "player"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for id := uint(1); id <= 7; id++ {
+		if game.players[id].Role == Werewolf {
+			wolfIDs = append(wolfIDs, id)
+		} else {
+			villagerIDs = append(villagerIDs, id)
+		}
+	}
+
+	for id := uint(1); id <= 7; id++ {
+		if _, _, err := game.Next(id); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if game.Phase != Noon {
+		t.Fatalf("Phase = %v, want %v", game.Phase, Noon)
+	}
+
+	return
+}
+
+func bindLovers(game *Game, firstID uint, secondID uint) {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	game.players[firstID].LoverID = secondID
+	game.players[secondID].LoverID = firstID
+}
+
+func TestForfeitClearsLoverVoteAtNoon(t *testing.T) {
+	game, wolfIDs, villagerIDs := startLoversGame(t)
+	bindLovers(game, villagerIDs[0], villagerIDs[1])
+
+	if _, _, err := game.Vote(villagerIDs[0], wolfIDs[0]); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := game.LockIn(villagerIDs[0]); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, _, err := game.Forfeit(villagerIDs[1]); err != nil {
+		t.Fatal(err)
+	}
+	if !game.players[villagerIDs[0]].IsDied {
+		t.Fatal("lover did not die with the forfeited player")
+	}
+
+	voterIDs := append([]uint{}, wolfIDs...)
+	voterIDs = append(voterIDs, villagerIDs[2:]...)
+	for i, id := range voterIDs {
+		if game.Phase != Noon {
+			t.Fatalf("Phase = %v after %d of %d voters locked in", game.Phase, i, len(voterIDs))
+		}
+		targetID := wolfIDs[0]
+		if id == wolfIDs[0] {
+			targetID = villagerIDs[2]
+		}
+		if _, _, err := game.Vote(id, targetID); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := game.LockIn(id); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if game.Phase != Night {
+		t.Fatalf("Phase = %v, want %v", game.Phase, Night)
+	}
+	if !game.players[wolfIDs[0]].IsDied {
+		t.Error("voted werewolf was not executed")
+	}
+}
+
+func TestForfeitClearsLoverKillVoteAtNight(t *testing.T) {
+	game, wolfIDs, villagerIDs := startLoversGame(t)
+
+	for id := uint(1); id <= 7; id++ {
+		targetID := villagerIDs[2]
+		if id == villagerIDs[2] {
+			targetID = villagerIDs[3]
+		}
+		if _, _, err := game.Vote(id, targetID); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := game.LockIn(id); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if game.Phase != Night {
+		t.Fatalf("Phase = %v, want %v", game.Phase, Night)
+	}
+
+	bindLovers(game, wolfIDs[0], villagerIDs[0])
+
+	if _, _, err := game.Kill(wolfIDs[0], villagerIDs[1]); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := game.LockIn(wolfIDs[0]); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, _, err := game.Forfeit(villagerIDs[0]); err != nil {
+		t.Fatal(err)
+	}
+	if !game.players[wolfIDs[0]].IsDied {
+		t.Fatal("lover did not die with the forfeited player")
+	}
+
+	if _, _, err := game.Kill(wolfIDs[1], villagerIDs[1]); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := game.LockIn(wolfIDs[1]); err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range villagerIDs[1:] {
+		if _, _, err := game.Next(id); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if game.Phase != Noon {
+		t.Fatalf("Phase = %v, want %v", game.Phase, Noon)
+	}
+	if !game.players[villagerIDs[1]].IsDied {
+		t.Error("attacked villager is alive")
+	}
+}
+
+func TestGameOverCarriesDeaths(t *testing.T) {
+	game := newTestGame(t, Config{PlayerNum: 3, RoleNums: map[Role]int{Werewolf: 1, Villager: 2}})
+
+	for id := uint(1); id <= 3; id++ {
+		game.Join(id, "player")
+	}
+
+	_, observer, err := game.ObserveState(1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wolfID, villagerID uint
+	for id, player := range game.players {
+		if player.Role == Werewolf {
+			wolfID = id
+		} else {
+			villagerID = id
+		}
+	}
+
+	for id := uint(1); id <= 3; id++ {
+		game.Next(id)
+	}
+	for id := uint(1); id <= 3; id++ {
+		targetID := wolfID
+		if id == wolfID {
+			targetID = villagerID
+		}
+		game.Vote(id, targetID)
+		game.LockIn(id)
+	}
+
+	if game.Phase != End {
+		t.Fatalf("Phase = %v, want %v", game.Phase, End)
+	}
+
+	var gameOver *StateChange
+	for stateChange := range observer.C() {
+		if stateChange.ChangeType == GameOver {
+			gameOver = &stateChange
+			break
+		}
+	}
+
+	if gameOver == nil {
+		t.Fatal("game over was not reported")
+	}
+	if len(gameOver.Deaths) != 1 || gameOver.Deaths[0].PlayerID != wolfID || gameOver.Deaths[0].Cause != Executed {
+		t.Errorf("Deaths = %v, want execution of %d", gameOver.Deaths, wolfID)
+	}
+}
+
+func TestObserveMessagesReplacesObserver(t *testing.T) {
+	game := newTestGame(t, Config{PlayerNum: 3, RoleNums: map[Role]int{Werewolf: 1, Villager: 2}})
+
+	for id := uint(1); id <= 2; id++ {
+		game.Join(id, "player")
+	}
+
+	_, stale, err := game.ObserveMessages(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, current, err := game.ObserveMessages(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := <-stale.C(); ok {
+		t.Error("stale observer received a value")
+	}
+	if stale.Err() != nil {
+		t.Errorf("Err() = %v, want nil", stale.Err())
+	}
+
+	if _, err := game.SendMessage(2, "hello"); err != nil {
+		t.Fatal(err)
+	}
+	if message := <-current.C(); message.Text != "hello" {
+		t.Errorf("Text = %q, want %q", message.Text, "hello")
+	}
+}
+
+func TestSendMessageDropsStalledObserver(t *testing.T) {
+	game := newTestGame(t, Config{PlayerNum: 3, RoleNums: map[Role]int{Werewolf: 1, Villager: 2}})
+
+	for id := uint(1); id <= 2; id++ {
+		game.Join(id, "player")
+	}
+
+	_, stalled, err := game.ObserveMessages(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i <= observerBufferSize; i++ {
+		if _, err := game.SendMessage(2, "hello"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	received := 0
+	for range stalled.C() {
+		received++
+	}
+
+	if received != observerBufferSize {
+		t.Errorf("received = %d, want %d", received, observerBufferSize)
+	}
+	if stalled.Err() != ErrObserverDropped {
+		t.Errorf("Err() = %v, want %v", stalled.Err(), ErrObserverDropped)
+	}
+}
+
+func TestObservePrivateEventsReplacesObserver(t *testing.T) {
+	game := newTestGame(t, Config{PlayerNum: 3, RoleNums: map[Role]int{Werewolf: 1, Villager: 2}})
+
+	game.Join(1, "player")
+
+	_, stale, err := game.ObservePrivateEvents(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, current, err := game.ObservePrivateEvents(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := <-stale.C(); ok {
+		t.Error("stale observer received a value")
+	}
+	if stale.Err() != nil {
+		t.Errorf("Err() = %v, want nil", stale.Err())
+	}
+
+	game.mu.Lock()
+	game.notifyPrivate(1, PrivateEvent{Type: KillVotesChanged})
+	game.mu.Unlock()
+
+	if privateEvent := <-current.C(); privateEvent.Type != KillVotesChanged {
+		t.Errorf("Type = %v, want %v", privateEvent.Type, KillVotesChanged)
+	}
+}
+
+func TestNotifyPrivateDropsStalledObserver(t *testing.T) {
+	game := newTestGame(t, Config{PlayerNum: 3, RoleNums: map[Role]int{Werewolf: 1, Villager: 2}})
+
+	game.Join(1, "player")
+
+	_, stalled, err := game.ObservePrivateEvents(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	game.mu.Lock()
+	for i := 0; i <= observerBufferSize; i++ {
+		game.notifyPrivate(1, PrivateEvent{Type: KillVotesChanged})
+	}
+	game.mu.Unlock()
+
+	received := 0
+	for range stalled.C() {
+		received++
+	}
+
+	if received != observerBufferSize {
+		t.Errorf("received = %d, want %d", received, observerBufferSize)
+	}
+	if stalled.Err() != ErrObserverDropped {
+		t.Errorf("Err() = %v, want %v", stalled.Err(), ErrObserverDropped)
+	}
+}
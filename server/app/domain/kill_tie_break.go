@@ -0,0 +1,9 @@
+package domain
+
+type KillTieBreak int
+
+const (
+	KillRandomly KillTieBreak = iota
+	KillNobody
+	KillByLeadWolf
+)
@@ -0,0 +1,55 @@
+package domain
+
+import "sync"
+
+const lobbyBufferSize = 64
+
+type Lobby struct {
+	observers map[uint]chan LobbyEvent
+	mu        sync.Mutex
+}
+
+func NewLobby() *Lobby {
+	return &Lobby{
+		observers: make(map[uint]chan LobbyEvent),
+	}
+}
+
+func (lobby *Lobby) Observe(userID uint) <-chan LobbyEvent {
+	lobby.mu.Lock()
+	defer lobby.mu.Unlock()
+
+	lobby.unobserve(userID)
+
+	ch := make(chan LobbyEvent, lobbyBufferSize)
+	lobby.observers[userID] = ch
+	return ch
+}
+
+func (lobby *Lobby) Unobserve(userID uint) {
+	lobby.mu.Lock()
+	defer lobby.mu.Unlock()
+	lobby.unobserve(userID)
+}
+
+func (lobby *Lobby) unobserve(userID uint) {
+	if ch, ok := lobby.observers[userID]; ok {
+		delete(lobby.observers, userID)
+		close(ch)
+	}
+}
+
+// Notify never blocks. An observer whose buffer is full is dropped and its
+// channel closed, so the client has to observe again and reload the listing.
+func (lobby *Lobby) Notify(event LobbyEvent) {
+	lobby.mu.Lock()
+	defer lobby.mu.Unlock()
+
+	for userID, ch := range lobby.observers {
+		select {
+		case ch <- event:
+		default:
+			lobby.unobserve(userID)
+		}
+	}
+}
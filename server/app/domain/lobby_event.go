@@ -0,0 +1,6 @@
+package domain
+
+type LobbyEvent struct {
+	Type  LobbyEventType
+	State State
+}
@@ -0,0 +1,11 @@
+package domain
+
+type LobbyEventType int
+
+const (
+	GameCreated LobbyEventType = iota
+	GamePlayerJoined
+	GamePlayerLeft
+	GameStarted
+	GameEnded
+)
@@ -0,0 +1,26 @@
+package domain
+
+import "testing"
+
+func TestLobbyObserveReplacesObserver(t *testing.T) {
+	lobby := NewLobby()
+
+	stale := lobby.Observe(1)
+	current := lobby.Observe(1)
+
+	if _, ok := <-stale; ok {
+		t.Error("stale observer received a value")
+	}
+
+	lobby.Notify(LobbyEvent{Type: GameCreated})
+
+	if event := <-current; event.Type != GameCreated {
+		t.Errorf("Type = %v, want %v", event.Type, GameCreated)
+	}
+
+	lobby.Unobserve(1)
+
+	if _, ok := <-current; ok {
+		t.Error("observer is still open after Unobserve")
+	}
+}
@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+type Message struct {
+	ID       uint
+	PlayerID uint
+	Channel  Channel
+	Text     string
+	Phase    Phase
+	Day      int
+	SentTime time.Time
+}
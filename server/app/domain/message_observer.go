@@ -0,0 +1,16 @@
+package domain
+
+type MessageObserver struct {
+	ch  chan Message
+	err error
+}
+
+func (observer *MessageObserver) C() <-chan Message {
+	return observer.ch
+}
+
+// Err reports why C was closed once it has been drained. It is nil when the
+// observation ended normally.
+func (observer *MessageObserver) Err() error {
+	return observer.err
+}
@@ -7,4 +7,5 @@ const (
 	Noon
 	Night
 	End
+	Hunting
 )
@@ -1,6 +1,8 @@
 package domain
 
 type PhaseResult struct {
-	KilledPlayerID uint
-	Winner         Side
+	Deaths             []Death
+	Winner             Side
+	RunoffCandidateIDs []uint
+	Votes              map[uint]uint
 }
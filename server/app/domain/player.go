@@ -3,11 +3,14 @@ package domain
 import "time"
 
 type Player struct {
-	ID         uint
-	Name       string
-	Role       Role
-	Side       Side
-	IsDied     bool
-	Index      int
-	JoinedTime time.Time
+	ID          uint
+	Name        string
+	Role        Role
+	Side        Side
+	IsDied      bool
+	IsForfeited bool
+	IsDummy     bool
+	Index       int
+	LoverID     uint
+	JoinedTime  time.Time
 }
@@ -0,0 +1,11 @@
+package domain
+
+type PrivateEvent struct {
+	ID              uint
+	Type            PrivateEventType
+	Phase           Phase
+	Day             int
+	Roles           map[uint]Role
+	Votes           map[uint]uint
+	LockedPlayerIDs []uint
+}
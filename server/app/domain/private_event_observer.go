@@ -0,0 +1,16 @@
+package domain
+
+type PrivateEventObserver struct {
+	ch  chan PrivateEvent
+	err error
+}
+
+func (observer *PrivateEventObserver) C() <-chan PrivateEvent {
+	return observer.ch
+}
+
+// Err reports why C was closed once it has been drained. It is nil when the
+// observation ended normally.
+func (observer *PrivateEventObserver) Err() error {
+	return observer.err
+}
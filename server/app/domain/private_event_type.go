@@ -0,0 +1,8 @@
+package domain
+
+type PrivateEventType int
+
+const (
+	RoleAssigned PrivateEventType = iota
+	KillVotesChanged
+)
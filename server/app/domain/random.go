@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+)
+
+type Random interface {
+	Seed() int64
+	Intn(n int) int
+}
+
+type hashRandom struct {
+	seed    int64
+	counter uint64
+}
+
+func NewRandom() (random Random, err error) {
+	n, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 63))
+	if err != nil {
+		return
+	}
+	random = NewSeededRandom(n.Int64())
+	return
+}
+
+func NewSeededRandom(seed int64) Random {
+	return &hashRandom{seed: seed}
+}
+
+func (random *hashRandom) Seed() int64 {
+	return random.seed
+}
+
+func (random *hashRandom) Intn(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+
+	max := ^uint64(0) - ^uint64(0)%uint64(n)
+	for {
+		if v := random.next(); v < max {
+			return int(v % uint64(n))
+		}
+	}
+}
+
+func (random *hashRandom) next() uint64 {
+	var block [16]byte
+	binary.BigEndian.PutUint64(block[:8], uint64(random.seed))
+	binary.BigEndian.PutUint64(block[8:], random.counter)
+	random.counter++
+
+	sum := sha256.Sum256(block[:])
+	return binary.BigEndian.Uint64(sum[:8])
+}
@@ -6,4 +6,5 @@ type GameRepository interface {
 	Store(game *domain.Game)
 	Delete(id string)
 	Load(id string) (game *domain.Game, err error)
+	FindByPhase(phase domain.Phase) (games []*domain.Game)
 }
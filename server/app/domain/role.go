@@ -6,4 +6,31 @@ const (
 	Unkown Role = iota
 	Villager
 	Werewolf
+	Seer
+	Bodyguard
+	Medium
+	Madman
+	Fox
+	Hunter
+	Cupid
 )
+
+func (role Role) Side() Side {
+	switch role {
+	case Unkown:
+		return Neutral
+	case Werewolf, Madman:
+		return Werewolves
+	case Fox:
+		return Foxes
+	default:
+		return Villagers
+	}
+}
+
+func (role Role) Species() Species {
+	if role == Werewolf {
+		return Wolf
+	}
+	return Human
+}
@@ -0,0 +1,8 @@
+package domain
+
+type RunoffVoters int
+
+const (
+	EveryoneVotes RunoffVoters = iota
+	NonCandidatesVote
+)
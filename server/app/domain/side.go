@@ -6,4 +6,6 @@ const (
 	Neutral Side = iota
 	Villagers
 	Werewolves
+	Foxes
+	Lovers
 )
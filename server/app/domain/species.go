@@ -0,0 +1,8 @@
+package domain
+
+type Species int
+
+const (
+	Human Species = iota
+	Wolf
+)
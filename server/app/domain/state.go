@@ -1,9 +1,14 @@
 package domain
 
+import "time"
+
 type State struct {
-	ID      string
-	Config  Config
-	Phase   Phase
-	Day     int
-	Players map[uint]Player
+	ID                 string
+	Config             Config
+	Phase              Phase
+	Day                int
+	OwnerID            uint
+	Players            map[uint]Player
+	Deadline           time.Time
+	RunoffCandidateIDs []uint
 }
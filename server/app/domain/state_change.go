@@ -1,11 +1,14 @@
 package domain
 
 type StateChange struct {
-	State          State
-	ChangeType     ChangeType
-	OldPhase       Phase
-	AddedPlayerID  uint
-	LeftPlayerID   uint
-	KilledPlayerID uint
-	Winner         Side
+	Sequence          uint64
+	State             State
+	ChangeType        ChangeType
+	OldPhase          Phase
+	AddedPlayerID     uint
+	LeftPlayerID      uint
+	ForfeitedPlayerID uint
+	Deaths            []Death
+	Winner            Side
+	Votes             map[uint]uint
 }
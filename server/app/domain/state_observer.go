@@ -0,0 +1,23 @@
+package domain
+
+import "errors"
+
+var (
+	ErrObserverDropped = errors.New("observer dropped")
+	ErrHistoryExpired  = errors.New("history expired")
+)
+
+type StateObserver struct {
+	ch  chan StateChange
+	err error
+}
+
+func (observer *StateObserver) C() <-chan StateChange {
+	return observer.ch
+}
+
+// Err reports why C was closed once it has been drained. It is nil when the
+// observation ended normally.
+func (observer *StateObserver) Err() error {
+	return observer.err
+}
@@ -3,6 +3,8 @@ package infrastracture
 import (
 	"context"
 	"errors"
+	"sort"
+	"time"
 
 	"github.com/f-miyu/jinrou/server/app/domain"
 	"github.com/f-miyu/jinrou/server/app/pb"
@@ -10,6 +12,7 @@ import (
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type tokenKey struct{}
@@ -59,11 +62,8 @@ func (s *JinrouServer) CreateGame(ctx context.Context, in *pb.CreateGameRequest)
 		return
 	}
 
-	config := domain.Config{
-		PlayerNum:   int(in.Config.PlayerNum),
-		WerewolfNum: int(in.Config.WerewolfNum),
-	}
-	state, err := s.gameUsecase.CreateGame(userID, config)
+	config := s.parseConfig(in.Config)
+	state, err := s.gameUsecase.CreateGame(userID, config, in.Private)
 	if err != nil {
 		return
 	}
@@ -75,6 +75,37 @@ func (s *JinrouServer) CreateGame(ctx context.Context, in *pb.CreateGameRequest)
 	return
 }
 
+func (s *JinrouServer) ListGames(ctx context.Context, in *pb.ListGamesRequest) (res *pb.ListGamesResponse, err error) {
+	_, err = s.getUserID(ctx)
+	if err != nil {
+		return
+	}
+
+	filter := domain.GameFilter{
+		OpenOnly: in.OpenOnly,
+		RoleNums: make(map[domain.Role]int),
+	}
+	for _, roleNum := range in.RoleNums {
+		filter.RoleNums[domain.Role(roleNum.Role)] = int(roleNum.Num)
+	}
+
+	states, total, err := s.gameUsecase.ListGames(filter, int(in.Offset), int(in.Limit))
+	if err != nil {
+		return
+	}
+
+	res = &pb.ListGamesResponse{
+		Games: make([]*pb.GameSummary, len(states)),
+		Total: int32(total),
+	}
+
+	for i, state := range states {
+		res.Games[i] = s.cnvertGameSummary(state)
+	}
+
+	return
+}
+
 func (s *JinrouServer) Join(ctx context.Context, in *pb.JoinRequest) (res *pb.JoinResponse, err error) {
 	userID, err := s.getUserID(ctx)
 	if err != nil {
@@ -111,6 +142,40 @@ func (s *JinrouServer) Leave(ctx context.Context, in *pb.LeaveRequest) (res *pb.
 	return
 }
 
+func (s *JinrouServer) Spectate(ctx context.Context, in *pb.SpectateRequest) (res *pb.SpectateResponse, err error) {
+	userID, err := s.getUserID(ctx)
+	if err != nil {
+		return
+	}
+
+	state, err := s.gameUsecase.Spectate(in.GameId, userID)
+	if err != nil {
+		return
+	}
+
+	res = &pb.SpectateResponse{
+		State: s.cnvertState(state),
+	}
+
+	return
+}
+
+func (s *JinrouServer) StopSpectating(ctx context.Context, in *pb.StopSpectatingRequest) (res *pb.StopSpectatingResponse, err error) {
+	userID, err := s.getUserID(ctx)
+	if err != nil {
+		return
+	}
+
+	err = s.gameUsecase.StopSpectating(in.GameId, userID)
+	if err != nil {
+		return
+	}
+
+	res = &pb.StopSpectatingResponse{}
+
+	return
+}
+
 func (s *JinrouServer) Vote(ctx context.Context, in *pb.VoteRequest) (res *pb.VoteResponse, err error) {
 	userID, err := s.getUserID(ctx)
 	if err != nil {
@@ -143,6 +208,143 @@ func (s *JinrouServer) Kill(ctx context.Context, in *pb.KillRequest) (res *pb.Ki
 	return
 }
 
+func (s *JinrouServer) LockIn(ctx context.Context, in *pb.LockInRequest) (res *pb.LockInResponse, err error) {
+	userID, err := s.getUserID(ctx)
+	if err != nil {
+		return
+	}
+
+	err = s.gameUsecase.LockIn(in.GameId, userID)
+	if err != nil {
+		return
+	}
+
+	res = &pb.LockInResponse{}
+
+	return
+}
+
+func (s *JinrouServer) RetractVote(ctx context.Context, in *pb.RetractVoteRequest) (res *pb.RetractVoteResponse, err error) {
+	userID, err := s.getUserID(ctx)
+	if err != nil {
+		return
+	}
+
+	err = s.gameUsecase.RetractVote(in.GameId, userID)
+	if err != nil {
+		return
+	}
+
+	res = &pb.RetractVoteResponse{}
+
+	return
+}
+
+func (s *JinrouServer) GetMyVote(ctx context.Context, in *pb.GetMyVoteRequest) (res *pb.GetMyVoteResponse, err error) {
+	userID, err := s.getUserID(ctx)
+	if err != nil {
+		return
+	}
+
+	targetID, voted, locked, err := s.gameUsecase.GetMyVote(in.GameId, userID)
+	if err != nil {
+		return
+	}
+
+	res = &pb.GetMyVoteResponse{
+		Voted:          voted,
+		TargetPlayerId: uint64(targetID),
+		Locked:         locked,
+	}
+
+	return
+}
+
+func (s *JinrouServer) Divine(ctx context.Context, in *pb.DivineRequest) (res *pb.DivineResponse, err error) {
+	userID, err := s.getUserID(ctx)
+	if err != nil {
+		return
+	}
+
+	side, err := s.gameUsecase.Divine(in.GameId, userID, uint(in.PlayerId))
+	if err != nil {
+		return
+	}
+
+	res = &pb.DivineResponse{
+		Side: pb.Side(side),
+	}
+
+	return
+}
+
+func (s *JinrouServer) Guard(ctx context.Context, in *pb.GuardRequest) (res *pb.GuardResponse, err error) {
+	userID, err := s.getUserID(ctx)
+	if err != nil {
+		return
+	}
+
+	err = s.gameUsecase.Guard(in.GameId, userID, uint(in.PlayerId))
+	if err != nil {
+		return
+	}
+
+	res = &pb.GuardResponse{}
+
+	return
+}
+
+func (s *JinrouServer) GetMediumResult(ctx context.Context, in *pb.GetMediumResultRequest) (res *pb.GetMediumResultResponse, err error) {
+	userID, err := s.getUserID(ctx)
+	if err != nil {
+		return
+	}
+
+	executedPlayerID, side, err := s.gameUsecase.GetMediumResult(in.GameId, userID)
+	if err != nil {
+		return
+	}
+
+	res = &pb.GetMediumResultResponse{
+		ExecutedPlayerId: uint64(executedPlayerID),
+		Side:             pb.Side(side),
+	}
+
+	return
+}
+
+func (s *JinrouServer) Shoot(ctx context.Context, in *pb.ShootRequest) (res *pb.ShootResponse, err error) {
+	userID, err := s.getUserID(ctx)
+	if err != nil {
+		return
+	}
+
+	err = s.gameUsecase.Shoot(in.GameId, userID, uint(in.PlayerId))
+	if err != nil {
+		return
+	}
+
+	res = &pb.ShootResponse{}
+
+	return
+}
+
+func (s *JinrouServer) Bind(ctx context.Context, in *pb.BindRequest) (res *pb.BindResponse, err error) {
+	userID, err := s.getUserID(ctx)
+	if err != nil {
+		return
+	}
+
+	err = s.gameUsecase.Bind(in.GameId, userID, uint(in.FirstPlayerId), uint(in.SecondPlayerId))
+	if err != nil {
+		return
+	}
+
+	res = &pb.BindResponse{}
+
+	return
+}
+
 func (s *JinrouServer) Next(ctx context.Context, in *pb.NextRequest) (res *pb.NextResponse, err error) {
 	userID, err := s.getUserID(ctx)
 	if err != nil {
@@ -159,6 +361,22 @@ func (s *JinrouServer) Next(ctx context.Context, in *pb.NextRequest) (res *pb.Ne
 	return
 }
 
+func (s *JinrouServer) Forfeit(ctx context.Context, in *pb.ForfeitRequest) (res *pb.ForfeitResponse, err error) {
+	userID, err := s.getUserID(ctx)
+	if err != nil {
+		return
+	}
+
+	err = s.gameUsecase.Forfeit(in.GameId, userID)
+	if err != nil {
+		return
+	}
+
+	res = &pb.ForfeitResponse{}
+
+	return
+}
+
 func (s *JinrouServer) GetRoles(ctx context.Context, in *pb.GetRolesRequest) (res *pb.GetRolesResponse, err error) {
 	userID, err := s.getUserID(ctx)
 	if err != nil {
@@ -181,49 +399,67 @@ func (s *JinrouServer) GetRoles(ctx context.Context, in *pb.GetRolesRequest) (re
 	return
 }
 
+func (s *JinrouServer) GetLover(ctx context.Context, in *pb.GetLoverRequest) (res *pb.GetLoverResponse, err error) {
+	userID, err := s.getUserID(ctx)
+	if err != nil {
+		return
+	}
+
+	loverID, err := s.gameUsecase.GetLoverID(in.GameId, userID)
+	if err != nil {
+		return
+	}
+
+	res = &pb.GetLoverResponse{
+		LoverPlayerId: uint64(loverID),
+	}
+
+	return
+}
+
 func (s *JinrouServer) ObserveState(req *pb.ObserveStateRequest, stream pb.Jinrou_ObserveStateServer) (err error) {
 	userID, err := s.getUserID(stream.Context())
 	if err != nil {
 		return
 	}
 
-	ch, err := s.gameUsecase.ObserveState(req.GameId, userID)
+	backlog, observer, err := s.gameUsecase.ObserveState(req.GameId, userID, req.LastSeenSequence)
+	if err == domain.ErrHistoryExpired {
+		st := status.New(codes.OutOfRange, err.Error())
+		err = st.Err()
+		return
+	}
 	if err != nil {
 		return
 	}
 
-	for change := range ch {
-		res := &pb.ObserveStateResponse{
-			State:      s.cnvertState(change.State),
-			ChangeType: pb.ChangeType(change.ChangeType),
-			OldPhase:   pb.Phase(change.OldPhase),
+	lastSequence := req.LastSeenSequence
+	for _, change := range backlog {
+		err = stream.Send(s.cnvertStateChange(change))
+		if err != nil {
+			s.gameUsecase.UnobserveState(req.GameId, userID)
+			return
 		}
-		switch change.ChangeType {
-		case domain.PlayerJoined:
-			res.Parameter = &pb.ObserveStateResponse_AddedPlayerId{
-				AddedPlayerId: uint64(change.AddedPlayerID),
-			}
-		case domain.PlayerLeft:
-			res.Parameter = &pb.ObserveStateResponse_LeftPlayerId{
-				LeftPlayerId: uint64(change.LeftPlayerID),
-			}
-		case domain.PhaseChanged:
-			res.Parameter = &pb.ObserveStateResponse_KilledPlayerId{
-				KilledPlayerId: uint64(change.KilledPlayerID),
-			}
-		case domain.GameOver:
-			res.Parameter = &pb.ObserveStateResponse_Winner{
-				Winner: pb.Side(change.Winner),
-			}
+		lastSequence = change.Sequence
+	}
+
+	for change := range observer.C() {
+		if change.Sequence <= lastSequence {
+			continue
 		}
 
-		err = stream.Send(res)
+		err = stream.Send(s.cnvertStateChange(change))
 		if err != nil {
 			s.gameUsecase.UnobserveState(req.GameId, userID)
 			return
 		}
 	}
 
+	if observer.Err() == domain.ErrObserverDropped {
+		st := status.New(codes.ResourceExhausted, observer.Err().Error())
+		err = st.Err()
+	}
+
 	return
 }
 
@@ -241,6 +477,173 @@ func (s *JinrouServer) UnobserveState(ctx context.Context, in *pb.UnobserveState
 	return
 }
 
+func (s *JinrouServer) SendMessage(ctx context.Context, in *pb.SendMessageRequest) (res *pb.SendMessageResponse, err error) {
+	userID, err := s.getUserID(ctx)
+	if err != nil {
+		return
+	}
+
+	err = s.gameUsecase.SendMessage(in.GameId, userID, in.Text)
+	if err != nil {
+		return
+	}
+
+	res = &pb.SendMessageResponse{}
+
+	return
+}
+
+func (s *JinrouServer) ObserveMessages(req *pb.ObserveMessagesRequest, stream pb.Jinrou_ObserveMessagesServer) (err error) {
+	userID, err := s.getUserID(stream.Context())
+	if err != nil {
+		return
+	}
+
+	backlog, observer, err := s.gameUsecase.ObserveMessages(req.GameId, userID)
+	if err != nil {
+		return
+	}
+
+	var lastID uint
+	for _, message := range backlog {
+		err = stream.Send(&pb.ObserveMessagesResponse{Message: s.cnvertMessage(message)})
+		if err != nil {
+			s.gameUsecase.UnobserveMessages(req.GameId, userID)
+			return
+		}
+		lastID = message.ID
+	}
+
+	for message := range observer.C() {
+		if message.ID <= lastID {
+			continue
+		}
+
+		err = stream.Send(&pb.ObserveMessagesResponse{Message: s.cnvertMessage(message)})
+		if err != nil {
+			s.gameUsecase.UnobserveMessages(req.GameId, userID)
+			return
+		}
+	}
+
+	if observer.Err() == domain.ErrObserverDropped {
+		st := status.New(codes.ResourceExhausted, observer.Err().Error())
+		err = st.Err()
+	}
+
+	return
+}
+
+func (s *JinrouServer) UnobserveMessages(ctx context.Context, in *pb.UnobserveMessagesRequest) (res *pb.UnobserveMessagesResponse, err error) {
+	userID, err := s.getUserID(ctx)
+	if err != nil {
+		return
+	}
+
+	err = s.gameUsecase.UnobserveMessages(in.GameId, userID)
+	if err != nil {
+		return
+	}
+
+	res = &pb.UnobserveMessagesResponse{}
+
+	return
+}
+
+func (s *JinrouServer) ObservePrivateEvents(req *pb.ObservePrivateEventsRequest, stream pb.Jinrou_ObservePrivateEventsServer) (err error) {
+	userID, err := s.getUserID(stream.Context())
+	if err != nil {
+		return
+	}
+
+	backlog, observer, err := s.gameUsecase.ObservePrivateEvents(req.GameId, userID)
+	if err != nil {
+		return
+	}
+
+	var lastID uint
+	for _, event := range backlog {
+		err = stream.Send(&pb.ObservePrivateEventsResponse{Event: s.cnvertPrivateEvent(event)})
+		if err != nil {
+			s.gameUsecase.UnobservePrivateEvents(req.GameId, userID)
+			return
+		}
+		lastID = event.ID
+	}
+
+	for event := range observer.C() {
+		if event.ID <= lastID {
+			continue
+		}
+
+		err = stream.Send(&pb.ObservePrivateEventsResponse{Event: s.cnvertPrivateEvent(event)})
+		if err != nil {
+			s.gameUsecase.UnobservePrivateEvents(req.GameId, userID)
+			return
+		}
+	}
+
+	if observer.Err() == domain.ErrObserverDropped {
+		st := status.New(codes.ResourceExhausted, observer.Err().Error())
+		err = st.Err()
+	}
+
+	return
+}
+
+func (s *JinrouServer) UnobservePrivateEvents(ctx context.Context, in *pb.UnobservePrivateEventsRequest) (res *pb.UnobservePrivateEventsResponse, err error) {
+	userID, err := s.getUserID(ctx)
+	if err != nil {
+		return
+	}
+
+	err = s.gameUsecase.UnobservePrivateEvents(in.GameId, userID)
+	if err != nil {
+		return
+	}
+
+	res = &pb.UnobservePrivateEventsResponse{}
+
+	return
+}
+
+func (s *JinrouServer) ObserveLobby(req *pb.ObserveLobbyRequest, stream pb.Jinrou_ObserveLobbyServer) (err error) {
+	userID, err := s.getUserID(stream.Context())
+	if err != nil {
+		return
+	}
+
+	ch := s.gameUsecase.ObserveLobby(userID)
+
+	for event := range ch {
+		res := &pb.ObserveLobbyResponse{
+			EventType: pb.LobbyEventType(event.Type),
+			Game:      s.cnvertGameSummary(event.State),
+		}
+
+		err = stream.Send(res)
+		if err != nil {
+			s.gameUsecase.UnobserveLobby(userID)
+			return
+		}
+	}
+
+	return
+}
+
+func (s *JinrouServer) UnobserveLobby(ctx context.Context, in *pb.UnobserveLobbyRequest) (res *pb.UnobserveLobbyResponse, err error) {
+	userID, err := s.getUserID(ctx)
+	if err != nil {
+		return
+	}
+
+	s.gameUsecase.UnobserveLobby(userID)
+
+	res = &pb.UnobserveLobbyResponse{}
+
+	return
+}
+
 func (s *JinrouServer) AuthFuncOverride(ctx context.Context, fullMethodName string) (newCtx context.Context, err error) {
 	if fullMethodName == "/jinrou.Jinrou/Register" || fullMethodName == "/jinrou.Jinrou/Refresh" {
 		newCtx = ctx
@@ -287,18 +690,187 @@ func (s *JinrouServer) cnvertState(state domain.State) *pb.State {
 			PlayerName: p.Name,
 			IsDied:     p.IsDied,
 			Index:      int32(p.Index),
+			IsDummy:    p.IsDummy,
 		}
 		players[uint64(k)] = player
 	}
 
+	var deadline *timestamppb.Timestamp
+	if !state.Deadline.IsZero() {
+		deadline = timestamppb.New(state.Deadline)
+	}
+
+	runoffCandidateIDs := make([]uint64, len(state.RunoffCandidateIDs))
+	for i, id := range state.RunoffCandidateIDs {
+		runoffCandidateIDs[i] = uint64(id)
+	}
+
 	return &pb.State{
-		GameId: state.ID,
-		Config: &pb.Config{
-			PlayerNum:   int32(state.Config.PlayerNum),
-			WerewolfNum: int32(state.Config.WerewolfNum),
-		},
-		Phase:   pb.Phase(state.Phase),
-		Day:     int32(state.Day),
-		Players: players,
+		GameId:             state.ID,
+		Config:             s.cnvertConfig(state.Config),
+		Phase:              pb.Phase(state.Phase),
+		Day:                int32(state.Day),
+		OwnerId:            uint64(state.OwnerID),
+		Players:            players,
+		Deadline:           deadline,
+		RunoffCandidateIds: runoffCandidateIDs,
+	}
+}
+
+func (s *JinrouServer) cnvertStateChange(change domain.StateChange) (res *pb.ObserveStateResponse) {
+	res = &pb.ObserveStateResponse{
+		Sequence:   change.Sequence,
+		State:      s.cnvertState(change.State),
+		ChangeType: pb.ChangeType(change.ChangeType),
+		OldPhase:   pb.Phase(change.OldPhase),
+		Deaths:     make([]*pb.Death, len(change.Deaths)),
+		Votes:      make(map[uint64]uint64),
+	}
+	for i, death := range change.Deaths {
+		res.Deaths[i] = &pb.Death{
+			PlayerId: uint64(death.PlayerID),
+			Cause:    pb.DeathCause(death.Cause),
+		}
+	}
+	for k, v := range change.Votes {
+		res.Votes[uint64(k)] = uint64(v)
+	}
+	switch change.ChangeType {
+	case domain.PlayerJoined:
+		res.Parameter = &pb.ObserveStateResponse_AddedPlayerId{
+			AddedPlayerId: uint64(change.AddedPlayerID),
+		}
+	case domain.PlayerLeft:
+		res.Parameter = &pb.ObserveStateResponse_LeftPlayerId{
+			LeftPlayerId: uint64(change.LeftPlayerID),
+		}
+	case domain.PhaseChanged, domain.PlayerShot:
+		if len(change.Deaths) > 0 {
+			res.Parameter = &pb.ObserveStateResponse_KilledPlayerId{
+				KilledPlayerId: uint64(change.Deaths[0].PlayerID),
+			}
+		}
+	case domain.PlayerForfeited:
+		res.Parameter = &pb.ObserveStateResponse_ForfeitedPlayerId{
+			ForfeitedPlayerId: uint64(change.ForfeitedPlayerID),
+		}
+	case domain.GameOver:
+		res.Parameter = &pb.ObserveStateResponse_Winner{
+			Winner: pb.Side(change.Winner),
+		}
+	}
+
+	return
+}
+
+func (s *JinrouServer) cnvertConfig(config domain.Config) *pb.Config {
+	roleNums := make([]*pb.RoleNum, 0, len(config.RoleNums))
+	for role, num := range config.RoleNums {
+		roleNums = append(roleNums, &pb.RoleNum{
+			Role: pb.Role(role),
+			Num:  int32(num),
+		})
+	}
+
+	sort.Slice(roleNums, func(i, j int) bool {
+		return roleNums[i].Role < roleNums[j].Role
+	})
+
+	return &pb.Config{
+		PlayerNum:             int32(config.PlayerNum),
+		WerewolfNum:           int32(config.RoleNums[domain.Werewolf]),
+		AllowConsecutiveGuard: config.AllowConsecutiveGuard,
+		RoleNums:              roleNums,
+		DiscussionSeconds:     int32(config.DiscussionTime / time.Second),
+		VotingSeconds:         int32(config.VotingTime / time.Second),
+		NightSeconds:          int32(config.NightTime / time.Second),
+		RandomKillOnTimeout:   config.RandomKillOnTimeout,
+		SpectatorsSeeRoles:    config.SpectatorsSeeRoles,
+		KillTieBreak:          pb.KillTieBreak(config.KillTieBreak),
+		RunoffVoters:          pb.RunoffVoters(config.RunoffVoters),
+		ExecutionTieBreak:     pb.ExecutionTieBreak(config.ExecutionTieBreak),
+		OpenBallots:           config.OpenBallots,
+		Scapegoat:             config.Scapegoat,
+		ScapegoatVillagerOnly: config.ScapegoatVillagerOnly,
+	}
+}
+
+func (s *JinrouServer) parseConfig(config *pb.Config) domain.Config {
+	roleNums := make(map[domain.Role]int)
+	for _, roleNum := range config.GetRoleNums() {
+		roleNums[domain.Role(roleNum.GetRole())] += int(roleNum.GetNum())
+	}
+
+	if len(roleNums) == 0 {
+		roleNums[domain.Werewolf] = int(config.GetWerewolfNum())
+		roleNums[domain.Villager] = int(config.GetPlayerNum() - config.GetWerewolfNum())
+		if config.GetScapegoat() {
+			roleNums[domain.Villager]++
+		}
+	}
+
+	return domain.Config{
+		PlayerNum:             int(config.GetPlayerNum()),
+		RoleNums:              roleNums,
+		AllowConsecutiveGuard: config.GetAllowConsecutiveGuard(),
+		DiscussionTime:        time.Duration(config.GetDiscussionSeconds()) * time.Second,
+		VotingTime:            time.Duration(config.GetVotingSeconds()) * time.Second,
+		NightTime:             time.Duration(config.GetNightSeconds()) * time.Second,
+		RandomKillOnTimeout:   config.GetRandomKillOnTimeout(),
+		SpectatorsSeeRoles:    config.GetSpectatorsSeeRoles(),
+		KillTieBreak:          domain.KillTieBreak(config.GetKillTieBreak()),
+		RunoffVoters:          domain.RunoffVoters(config.GetRunoffVoters()),
+		ExecutionTieBreak:     domain.ExecutionTieBreak(config.GetExecutionTieBreak()),
+		OpenBallots:           config.GetOpenBallots(),
+		Scapegoat:             config.GetScapegoat(),
+		ScapegoatVillagerOnly: config.GetScapegoatVillagerOnly(),
+	}
+}
+
+func (s *JinrouServer) cnvertMessage(message domain.Message) *pb.Message {
+	return &pb.Message{
+		MessageId: uint64(message.ID),
+		PlayerId:  uint64(message.PlayerID),
+		Channel:   pb.Channel(message.Channel),
+		Text:      message.Text,
+		Phase:     pb.Phase(message.Phase),
+		Day:       int32(message.Day),
+		SentTime:  timestamppb.New(message.SentTime),
+	}
+}
+
+func (s *JinrouServer) cnvertPrivateEvent(event domain.PrivateEvent) *pb.PrivateEvent {
+	roles := make(map[uint64]pb.Role)
+	for k, r := range event.Roles {
+		roles[uint64(k)] = pb.Role(r)
+	}
+
+	votes := make(map[uint64]uint64)
+	for k, v := range event.Votes {
+		votes[uint64(k)] = uint64(v)
+	}
+
+	lockedPlayerIDs := make([]uint64, len(event.LockedPlayerIDs))
+	for i, id := range event.LockedPlayerIDs {
+		lockedPlayerIDs[i] = uint64(id)
+	}
+
+	return &pb.PrivateEvent{
+		EventId:         uint64(event.ID),
+		Type:            pb.PrivateEventType(event.Type),
+		Phase:           pb.Phase(event.Phase),
+		Day:             int32(event.Day),
+		Roles:           roles,
+		Votes:           votes,
+		LockedPlayerIds: lockedPlayerIDs,
+	}
+}
+
+func (s *JinrouServer) cnvertGameSummary(state domain.State) *pb.GameSummary {
+	return &pb.GameSummary{
+		GameId:    state.ID,
+		Config:    s.cnvertConfig(state.Config),
+		PlayerNum: int32(len(state.Players)),
+		OwnerName: state.Players[state.OwnerID].Name,
 	}
 }
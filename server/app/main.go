@@ -2,10 +2,8 @@ package main
 
 import (
 	"fmt"
-	"math/rand"
 	"net"
 	"os"
-	"time"
 
 	"github.com/f-miyu/jinrou/server/app/data/entity"
 	"github.com/f-miyu/jinrou/server/app/domain/service"
@@ -17,8 +15,6 @@ import (
 )
 
 func main() {
-	rand.Seed(time.Now().UnixNano())
-
 	db, err := connectDB()
 	if err != nil {
 		fmt.Println(err)
@@ -9,6 +9,7 @@ package pb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -23,10 +24,11 @@ const (
 type Phase int32
 
 const (
-	Phase_START Phase = 0
-	Phase_NOON  Phase = 1
-	Phase_NIGHT Phase = 2
-	Phase_END   Phase = 3
+	Phase_START   Phase = 0
+	Phase_NOON    Phase = 1
+	Phase_NIGHT   Phase = 2
+	Phase_END     Phase = 3
+	Phase_HUNTING Phase = 4
 )
 
 // Enum value maps for Phase.
@@ -36,12 +38,14 @@ var (
 		1: "NOON",
 		2: "NIGHT",
 		3: "END",
+		4: "HUNTING",
 	}
 	Phase_value = map[string]int32{
-		"START": 0,
-		"NOON":  1,
-		"NIGHT": 2,
-		"END":   3,
+		"START":   0,
+		"NOON":    1,
+		"NIGHT":   2,
+		"END":     3,
+		"HUNTING": 4,
 	}
 )
 
@@ -78,6 +82,8 @@ const (
 	Side_NEUTRAL    Side = 0
 	Side_VILLAGERS  Side = 1
 	Side_WEREWOLVES Side = 2
+	Side_FOXES      Side = 3
+	Side_LOVERS     Side = 4
 )
 
 // Enum value maps for Side.
@@ -86,11 +92,15 @@ var (
 		0: "NEUTRAL",
 		1: "VILLAGERS",
 		2: "WEREWOLVES",
+		3: "FOXES",
+		4: "LOVERS",
 	}
 	Side_value = map[string]int32{
 		"NEUTRAL":    0,
 		"VILLAGERS":  1,
 		"WEREWOLVES": 2,
+		"FOXES":      3,
+		"LOVERS":     4,
 	}
 )
 
@@ -124,9 +134,16 @@ func (Side) EnumDescriptor() ([]byte, []int) {
 type Role int32
 
 const (
-	Role_UNKOWN   Role = 0
-	Role_VILLAGER Role = 1
-	Role_WEREWOLF Role = 2
+	Role_UNKOWN    Role = 0
+	Role_VILLAGER  Role = 1
+	Role_WEREWOLF  Role = 2
+	Role_SEER      Role = 3
+	Role_BODYGUARD Role = 4
+	Role_MEDIUM    Role = 5
+	Role_MADMAN    Role = 6
+	Role_FOX       Role = 7
+	Role_HUNTER    Role = 8
+	Role_CUPID     Role = 9
 )
 
 // Enum value maps for Role.
@@ -135,11 +152,25 @@ var (
 		0: "UNKOWN",
 		1: "VILLAGER",
 		2: "WEREWOLF",
+		3: "SEER",
+		4: "BODYGUARD",
+		5: "MEDIUM",
+		6: "MADMAN",
+		7: "FOX",
+		8: "HUNTER",
+		9: "CUPID",
 	}
 	Role_value = map[string]int32{
-		"UNKOWN":   0,
-		"VILLAGER": 1,
-		"WEREWOLF": 2,
+		"UNKOWN":    0,
+		"VILLAGER":  1,
+		"WEREWOLF":  2,
+		"SEER":      3,
+		"BODYGUARD": 4,
+		"MEDIUM":    5,
+		"MADMAN":    6,
+		"FOX":       7,
+		"HUNTER":    8,
+		"CUPID":     9,
 	}
 )
 
@@ -178,6 +209,9 @@ const (
 	ChangeType_PHASE_CHANGED                 ChangeType = 2
 	ChangeType_PHASE_CHANGED_WITHOUT_KILLING ChangeType = 3
 	ChangeType_GAME_OVER                     ChangeType = 4
+	ChangeType_PLAYER_SHOT                   ChangeType = 5
+	ChangeType_PLAYER_FORFEITED              ChangeType = 6
+	ChangeType_RUNOFF_STARTED                ChangeType = 7
 )
 
 // Enum value maps for ChangeType.
@@ -188,6 +222,9 @@ var (
 		2: "PHASE_CHANGED",
 		3: "PHASE_CHANGED_WITHOUT_KILLING",
 		4: "GAME_OVER",
+		5: "PLAYER_SHOT",
+		6: "PLAYER_FORFEITED",
+		7: "RUNOFF_STARTED",
 	}
 	ChangeType_value = map[string]int32{
 		"PLAYER_JOINED":                 0,
@@ -195,6 +232,9 @@ var (
 		"PHASE_CHANGED":                 2,
 		"PHASE_CHANGED_WITHOUT_KILLING": 3,
 		"GAME_OVER":                     4,
+		"PLAYER_SHOT":                   5,
+		"PLAYER_FORFEITED":              6,
+		"RUNOFF_STARTED":                7,
 	}
 )
 
@@ -225,6 +265,355 @@ func (ChangeType) EnumDescriptor() ([]byte, []int) {
 	return file_jinrou_proto_rawDescGZIP(), []int{3}
 }
 
+type DeathCause int32
+
+const (
+	DeathCause_EXECUTED  DeathCause = 0
+	DeathCause_ATTACKED  DeathCause = 1
+	DeathCause_CURSED    DeathCause = 2
+	DeathCause_SHOT      DeathCause = 3
+	DeathCause_SUICIDE   DeathCause = 4
+	DeathCause_FORFEITED DeathCause = 5
+)
+
+// Enum value maps for DeathCause.
+var (
+	DeathCause_name = map[int32]string{
+		0: "EXECUTED",
+		1: "ATTACKED",
+		2: "CURSED",
+		3: "SHOT",
+		4: "SUICIDE",
+		5: "FORFEITED",
+	}
+	DeathCause_value = map[string]int32{
+		"EXECUTED":  0,
+		"ATTACKED":  1,
+		"CURSED":    2,
+		"SHOT":      3,
+		"SUICIDE":   4,
+		"FORFEITED": 5,
+	}
+)
+
+func (x DeathCause) Enum() *DeathCause {
+	p := new(DeathCause)
+	*p = x
+	return p
+}
+
+func (x DeathCause) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeathCause) Descriptor() protoreflect.EnumDescriptor {
+	return file_jinrou_proto_enumTypes[4].Descriptor()
+}
+
+func (DeathCause) Type() protoreflect.EnumType {
+	return &file_jinrou_proto_enumTypes[4]
+}
+
+func (x DeathCause) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeathCause.Descriptor instead.
+func (DeathCause) EnumDescriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{4}
+}
+
+type Channel int32
+
+const (
+	Channel_PUBLIC_CHANNEL    Channel = 0
+	Channel_WEREWOLF_CHANNEL  Channel = 1
+	Channel_GRAVEYARD_CHANNEL Channel = 2
+)
+
+// Enum value maps for Channel.
+var (
+	Channel_name = map[int32]string{
+		0: "PUBLIC_CHANNEL",
+		1: "WEREWOLF_CHANNEL",
+		2: "GRAVEYARD_CHANNEL",
+	}
+	Channel_value = map[string]int32{
+		"PUBLIC_CHANNEL":    0,
+		"WEREWOLF_CHANNEL":  1,
+		"GRAVEYARD_CHANNEL": 2,
+	}
+)
+
+func (x Channel) Enum() *Channel {
+	p := new(Channel)
+	*p = x
+	return p
+}
+
+func (x Channel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Channel) Descriptor() protoreflect.EnumDescriptor {
+	return file_jinrou_proto_enumTypes[5].Descriptor()
+}
+
+func (Channel) Type() protoreflect.EnumType {
+	return &file_jinrou_proto_enumTypes[5]
+}
+
+func (x Channel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Channel.Descriptor instead.
+func (Channel) EnumDescriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{5}
+}
+
+type PrivateEventType int32
+
+const (
+	PrivateEventType_ROLE_ASSIGNED      PrivateEventType = 0
+	PrivateEventType_KILL_VOTES_CHANGED PrivateEventType = 1
+)
+
+// Enum value maps for PrivateEventType.
+var (
+	PrivateEventType_name = map[int32]string{
+		0: "ROLE_ASSIGNED",
+		1: "KILL_VOTES_CHANGED",
+	}
+	PrivateEventType_value = map[string]int32{
+		"ROLE_ASSIGNED":      0,
+		"KILL_VOTES_CHANGED": 1,
+	}
+)
+
+func (x PrivateEventType) Enum() *PrivateEventType {
+	p := new(PrivateEventType)
+	*p = x
+	return p
+}
+
+func (x PrivateEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PrivateEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_jinrou_proto_enumTypes[6].Descriptor()
+}
+
+func (PrivateEventType) Type() protoreflect.EnumType {
+	return &file_jinrou_proto_enumTypes[6]
+}
+
+func (x PrivateEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PrivateEventType.Descriptor instead.
+func (PrivateEventType) EnumDescriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{6}
+}
+
+type KillTieBreak int32
+
+const (
+	KillTieBreak_KILL_RANDOMLY     KillTieBreak = 0
+	KillTieBreak_KILL_NOBODY       KillTieBreak = 1
+	KillTieBreak_KILL_BY_LEAD_WOLF KillTieBreak = 2
+)
+
+// Enum value maps for KillTieBreak.
+var (
+	KillTieBreak_name = map[int32]string{
+		0: "KILL_RANDOMLY",
+		1: "KILL_NOBODY",
+		2: "KILL_BY_LEAD_WOLF",
+	}
+	KillTieBreak_value = map[string]int32{
+		"KILL_RANDOMLY":     0,
+		"KILL_NOBODY":       1,
+		"KILL_BY_LEAD_WOLF": 2,
+	}
+)
+
+func (x KillTieBreak) Enum() *KillTieBreak {
+	p := new(KillTieBreak)
+	*p = x
+	return p
+}
+
+func (x KillTieBreak) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KillTieBreak) Descriptor() protoreflect.EnumDescriptor {
+	return file_jinrou_proto_enumTypes[7].Descriptor()
+}
+
+func (KillTieBreak) Type() protoreflect.EnumType {
+	return &file_jinrou_proto_enumTypes[7]
+}
+
+func (x KillTieBreak) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KillTieBreak.Descriptor instead.
+func (KillTieBreak) EnumDescriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{7}
+}
+
+type RunoffVoters int32
+
+const (
+	RunoffVoters_EVERYONE_VOTES      RunoffVoters = 0
+	RunoffVoters_NON_CANDIDATES_VOTE RunoffVoters = 1
+)
+
+// Enum value maps for RunoffVoters.
+var (
+	RunoffVoters_name = map[int32]string{
+		0: "EVERYONE_VOTES",
+		1: "NON_CANDIDATES_VOTE",
+	}
+	RunoffVoters_value = map[string]int32{
+		"EVERYONE_VOTES":      0,
+		"NON_CANDIDATES_VOTE": 1,
+	}
+)
+
+func (x RunoffVoters) Enum() *RunoffVoters {
+	p := new(RunoffVoters)
+	*p = x
+	return p
+}
+
+func (x RunoffVoters) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RunoffVoters) Descriptor() protoreflect.EnumDescriptor {
+	return file_jinrou_proto_enumTypes[8].Descriptor()
+}
+
+func (RunoffVoters) Type() protoreflect.EnumType {
+	return &file_jinrou_proto_enumTypes[8]
+}
+
+func (x RunoffVoters) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RunoffVoters.Descriptor instead.
+func (RunoffVoters) EnumDescriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{8}
+}
+
+type ExecutionTieBreak int32
+
+const (
+	ExecutionTieBreak_EXECUTE_NOBODY   ExecutionTieBreak = 0
+	ExecutionTieBreak_EXECUTE_RANDOMLY ExecutionTieBreak = 1
+)
+
+// Enum value maps for ExecutionTieBreak.
+var (
+	ExecutionTieBreak_name = map[int32]string{
+		0: "EXECUTE_NOBODY",
+		1: "EXECUTE_RANDOMLY",
+	}
+	ExecutionTieBreak_value = map[string]int32{
+		"EXECUTE_NOBODY":   0,
+		"EXECUTE_RANDOMLY": 1,
+	}
+)
+
+func (x ExecutionTieBreak) Enum() *ExecutionTieBreak {
+	p := new(ExecutionTieBreak)
+	*p = x
+	return p
+}
+
+func (x ExecutionTieBreak) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExecutionTieBreak) Descriptor() protoreflect.EnumDescriptor {
+	return file_jinrou_proto_enumTypes[9].Descriptor()
+}
+
+func (ExecutionTieBreak) Type() protoreflect.EnumType {
+	return &file_jinrou_proto_enumTypes[9]
+}
+
+func (x ExecutionTieBreak) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExecutionTieBreak.Descriptor instead.
+func (ExecutionTieBreak) EnumDescriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{9}
+}
+
+type LobbyEventType int32
+
+const (
+	LobbyEventType_GAME_CREATED       LobbyEventType = 0
+	LobbyEventType_GAME_PLAYER_JOINED LobbyEventType = 1
+	LobbyEventType_GAME_PLAYER_LEFT   LobbyEventType = 2
+	LobbyEventType_GAME_STARTED       LobbyEventType = 3
+	LobbyEventType_GAME_ENDED         LobbyEventType = 4
+)
+
+// Enum value maps for LobbyEventType.
+var (
+	LobbyEventType_name = map[int32]string{
+		0: "GAME_CREATED",
+		1: "GAME_PLAYER_JOINED",
+		2: "GAME_PLAYER_LEFT",
+		3: "GAME_STARTED",
+		4: "GAME_ENDED",
+	}
+	LobbyEventType_value = map[string]int32{
+		"GAME_CREATED":       0,
+		"GAME_PLAYER_JOINED": 1,
+		"GAME_PLAYER_LEFT":   2,
+		"GAME_STARTED":       3,
+		"GAME_ENDED":         4,
+	}
+)
+
+func (x LobbyEventType) Enum() *LobbyEventType {
+	p := new(LobbyEventType)
+	*p = x
+	return p
+}
+
+func (x LobbyEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LobbyEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_jinrou_proto_enumTypes[10].Descriptor()
+}
+
+func (LobbyEventType) Type() protoreflect.EnumType {
+	return &file_jinrou_proto_enumTypes[10]
+}
+
+func (x LobbyEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LobbyEventType.Descriptor instead.
+func (LobbyEventType) EnumDescriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{10}
+}
+
 type RegisterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -442,7 +831,8 @@ type CreateGameRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Config *Config `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Config  *Config `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Private bool    `protobuf:"varint,2,opt,name=private,proto3" json:"private,omitempty"`
 }
 
 func (x *CreateGameRequest) Reset() {
@@ -484,6 +874,13 @@ func (x *CreateGameRequest) GetConfig() *Config {
 	return nil
 }
 
+func (x *CreateGameRequest) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
 type CreateGameResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -531,16 +928,19 @@ func (x *CreateGameResponse) GetState() *State {
 	return nil
 }
 
-type JoinRequest struct {
+type ListGamesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	OpenOnly bool       `protobuf:"varint,1,opt,name=open_only,json=openOnly,proto3" json:"open_only,omitempty"`
+	RoleNums []*RoleNum `protobuf:"bytes,2,rep,name=role_nums,json=roleNums,proto3" json:"role_nums,omitempty"`
+	Offset   int32      `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit    int32      `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *JoinRequest) Reset() {
-	*x = JoinRequest{}
+func (x *ListGamesRequest) Reset() {
+	*x = ListGamesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_jinrou_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -548,13 +948,13 @@ func (x *JoinRequest) Reset() {
 	}
 }
 
-func (x *JoinRequest) String() string {
+func (x *ListGamesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinRequest) ProtoMessage() {}
+func (*ListGamesRequest) ProtoMessage() {}
 
-func (x *JoinRequest) ProtoReflect() protoreflect.Message {
+func (x *ListGamesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_jinrou_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -566,28 +966,50 @@ func (x *JoinRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinRequest.ProtoReflect.Descriptor instead.
-func (*JoinRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListGamesRequest.ProtoReflect.Descriptor instead.
+func (*ListGamesRequest) Descriptor() ([]byte, []int) {
 	return file_jinrou_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *JoinRequest) GetGameId() string {
+func (x *ListGamesRequest) GetOpenOnly() bool {
 	if x != nil {
-		return x.GameId
+		return x.OpenOnly
 	}
-	return ""
+	return false
 }
 
-type JoinResponse struct {
+func (x *ListGamesRequest) GetRoleNums() []*RoleNum {
+	if x != nil {
+		return x.RoleNums
+	}
+	return nil
+}
+
+func (x *ListGamesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListGamesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListGamesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	State *State `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Games []*GameSummary `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	Total int32          `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (x *JoinResponse) Reset() {
-	*x = JoinResponse{}
+func (x *ListGamesResponse) Reset() {
+	*x = ListGamesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_jinrou_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -595,13 +1017,13 @@ func (x *JoinResponse) Reset() {
 	}
 }
 
-func (x *JoinResponse) String() string {
+func (x *ListGamesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinResponse) ProtoMessage() {}
+func (*ListGamesResponse) ProtoMessage() {}
 
-func (x *JoinResponse) ProtoReflect() protoreflect.Message {
+func (x *ListGamesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_jinrou_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -613,28 +1035,33 @@ func (x *JoinResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinResponse.ProtoReflect.Descriptor instead.
-func (*JoinResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListGamesResponse.ProtoReflect.Descriptor instead.
+func (*ListGamesResponse) Descriptor() ([]byte, []int) {
 	return file_jinrou_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *JoinResponse) GetState() *State {
+func (x *ListGamesResponse) GetGames() []*GameSummary {
 	if x != nil {
-		return x.State
+		return x.Games
 	}
 	return nil
 }
 
-type LeaveRequest struct {
+func (x *ListGamesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ObserveLobbyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 }
 
-func (x *LeaveRequest) Reset() {
-	*x = LeaveRequest{}
+func (x *ObserveLobbyRequest) Reset() {
+	*x = ObserveLobbyRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_jinrou_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -642,13 +1069,13 @@ func (x *LeaveRequest) Reset() {
 	}
 }
 
-func (x *LeaveRequest) String() string {
+func (x *ObserveLobbyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaveRequest) ProtoMessage() {}
+func (*ObserveLobbyRequest) ProtoMessage() {}
 
-func (x *LeaveRequest) ProtoReflect() protoreflect.Message {
+func (x *ObserveLobbyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_jinrou_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -660,28 +1087,22 @@ func (x *LeaveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaveRequest.ProtoReflect.Descriptor instead.
-func (*LeaveRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ObserveLobbyRequest.ProtoReflect.Descriptor instead.
+func (*ObserveLobbyRequest) Descriptor() ([]byte, []int) {
 	return file_jinrou_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *LeaveRequest) GetGameId() string {
-	if x != nil {
-		return x.GameId
-	}
-	return ""
-}
-
-type LeaveResponse struct {
+type ObserveLobbyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	State *State `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	EventType LobbyEventType `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=jinrou.LobbyEventType" json:"event_type,omitempty"`
+	Game      *GameSummary   `protobuf:"bytes,2,opt,name=game,proto3" json:"game,omitempty"`
 }
 
-func (x *LeaveResponse) Reset() {
-	*x = LeaveResponse{}
+func (x *ObserveLobbyResponse) Reset() {
+	*x = ObserveLobbyResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_jinrou_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -689,13 +1110,13 @@ func (x *LeaveResponse) Reset() {
 	}
 }
 
-func (x *LeaveResponse) String() string {
+func (x *ObserveLobbyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaveResponse) ProtoMessage() {}
+func (*ObserveLobbyResponse) ProtoMessage() {}
 
-func (x *LeaveResponse) ProtoReflect() protoreflect.Message {
+func (x *ObserveLobbyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_jinrou_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -707,29 +1128,33 @@ func (x *LeaveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaveResponse.ProtoReflect.Descriptor instead.
-func (*LeaveResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ObserveLobbyResponse.ProtoReflect.Descriptor instead.
+func (*ObserveLobbyResponse) Descriptor() ([]byte, []int) {
 	return file_jinrou_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *LeaveResponse) GetState() *State {
+func (x *ObserveLobbyResponse) GetEventType() LobbyEventType {
 	if x != nil {
-		return x.State
+		return x.EventType
+	}
+	return LobbyEventType_GAME_CREATED
+}
+
+func (x *ObserveLobbyResponse) GetGame() *GameSummary {
+	if x != nil {
+		return x.Game
 	}
 	return nil
 }
 
-type VoteRequest struct {
+type UnobserveLobbyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	GameId   string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	PlayerId uint64 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 }
 
-func (x *VoteRequest) Reset() {
-	*x = VoteRequest{}
+func (x *UnobserveLobbyRequest) Reset() {
+	*x = UnobserveLobbyRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_jinrou_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -737,13 +1162,13 @@ func (x *VoteRequest) Reset() {
 	}
 }
 
-func (x *VoteRequest) String() string {
+func (x *UnobserveLobbyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VoteRequest) ProtoMessage() {}
+func (*UnobserveLobbyRequest) ProtoMessage() {}
 
-func (x *VoteRequest) ProtoReflect() protoreflect.Message {
+func (x *UnobserveLobbyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_jinrou_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -755,33 +1180,19 @@ func (x *VoteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VoteRequest.ProtoReflect.Descriptor instead.
-func (*VoteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnobserveLobbyRequest.ProtoReflect.Descriptor instead.
+func (*UnobserveLobbyRequest) Descriptor() ([]byte, []int) {
 	return file_jinrou_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *VoteRequest) GetGameId() string {
-	if x != nil {
-		return x.GameId
-	}
-	return ""
-}
-
-func (x *VoteRequest) GetPlayerId() uint64 {
-	if x != nil {
-		return x.PlayerId
-	}
-	return 0
-}
-
-type VoteResponse struct {
+type UnobserveLobbyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *VoteResponse) Reset() {
-	*x = VoteResponse{}
+func (x *UnobserveLobbyResponse) Reset() {
+	*x = UnobserveLobbyResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_jinrou_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -789,13 +1200,13 @@ func (x *VoteResponse) Reset() {
 	}
 }
 
-func (x *VoteResponse) String() string {
+func (x *UnobserveLobbyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VoteResponse) ProtoMessage() {}
+func (*UnobserveLobbyResponse) ProtoMessage() {}
 
-func (x *VoteResponse) ProtoReflect() protoreflect.Message {
+func (x *UnobserveLobbyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_jinrou_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -807,22 +1218,21 @@ func (x *VoteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VoteResponse.ProtoReflect.Descriptor instead.
-func (*VoteResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnobserveLobbyResponse.ProtoReflect.Descriptor instead.
+func (*UnobserveLobbyResponse) Descriptor() ([]byte, []int) {
 	return file_jinrou_proto_rawDescGZIP(), []int{11}
 }
 
-type KillRequest struct {
+type JoinRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GameId   string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	PlayerId uint64 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 }
 
-func (x *KillRequest) Reset() {
-	*x = KillRequest{}
+func (x *JoinRequest) Reset() {
+	*x = JoinRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_jinrou_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -830,13 +1240,13 @@ func (x *KillRequest) Reset() {
 	}
 }
 
-func (x *KillRequest) String() string {
+func (x *JoinRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KillRequest) ProtoMessage() {}
+func (*JoinRequest) ProtoMessage() {}
 
-func (x *KillRequest) ProtoReflect() protoreflect.Message {
+func (x *JoinRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_jinrou_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -848,33 +1258,28 @@ func (x *KillRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KillRequest.ProtoReflect.Descriptor instead.
-func (*KillRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinRequest.ProtoReflect.Descriptor instead.
+func (*JoinRequest) Descriptor() ([]byte, []int) {
 	return file_jinrou_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *KillRequest) GetGameId() string {
+func (x *JoinRequest) GetGameId() string {
 	if x != nil {
 		return x.GameId
 	}
 	return ""
 }
 
-func (x *KillRequest) GetPlayerId() uint64 {
-	if x != nil {
-		return x.PlayerId
-	}
-	return 0
-}
-
-type KillResponse struct {
+type JoinResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	State *State `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
 }
 
-func (x *KillResponse) Reset() {
-	*x = KillResponse{}
+func (x *JoinResponse) Reset() {
+	*x = JoinResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_jinrou_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -882,13 +1287,13 @@ func (x *KillResponse) Reset() {
 	}
 }
 
-func (x *KillResponse) String() string {
+func (x *JoinResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KillResponse) ProtoMessage() {}
+func (*JoinResponse) ProtoMessage() {}
 
-func (x *KillResponse) ProtoReflect() protoreflect.Message {
+func (x *JoinResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_jinrou_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -900,12 +1305,19 @@ func (x *KillResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KillResponse.ProtoReflect.Descriptor instead.
-func (*KillResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinResponse.ProtoReflect.Descriptor instead.
+func (*JoinResponse) Descriptor() ([]byte, []int) {
 	return file_jinrou_proto_rawDescGZIP(), []int{13}
 }
 
-type NextRequest struct {
+func (x *JoinResponse) GetState() *State {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+type LeaveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -913,8 +1325,8 @@ type NextRequest struct {
 	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 }
 
-func (x *NextRequest) Reset() {
-	*x = NextRequest{}
+func (x *LeaveRequest) Reset() {
+	*x = LeaveRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_jinrou_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -922,13 +1334,13 @@ func (x *NextRequest) Reset() {
 	}
 }
 
-func (x *NextRequest) String() string {
+func (x *LeaveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NextRequest) ProtoMessage() {}
+func (*LeaveRequest) ProtoMessage() {}
 
-func (x *NextRequest) ProtoReflect() protoreflect.Message {
+func (x *LeaveRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_jinrou_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -940,26 +1352,28 @@ func (x *NextRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NextRequest.ProtoReflect.Descriptor instead.
-func (*NextRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LeaveRequest.ProtoReflect.Descriptor instead.
+func (*LeaveRequest) Descriptor() ([]byte, []int) {
 	return file_jinrou_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *NextRequest) GetGameId() string {
+func (x *LeaveRequest) GetGameId() string {
 	if x != nil {
 		return x.GameId
 	}
 	return ""
 }
 
-type NextResponse struct {
+type LeaveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	State *State `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
 }
 
-func (x *NextResponse) Reset() {
-	*x = NextResponse{}
+func (x *LeaveResponse) Reset() {
+	*x = LeaveResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_jinrou_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -967,13 +1381,13 @@ func (x *NextResponse) Reset() {
 	}
 }
 
-func (x *NextResponse) String() string {
+func (x *LeaveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NextResponse) ProtoMessage() {}
+func (*LeaveResponse) ProtoMessage() {}
 
-func (x *NextResponse) ProtoReflect() protoreflect.Message {
+func (x *LeaveResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_jinrou_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -985,12 +1399,19 @@ func (x *NextResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NextResponse.ProtoReflect.Descriptor instead.
-func (*NextResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LeaveResponse.ProtoReflect.Descriptor instead.
+func (*LeaveResponse) Descriptor() ([]byte, []int) {
 	return file_jinrou_proto_rawDescGZIP(), []int{15}
 }
 
-type GetRolesRequest struct {
+func (x *LeaveResponse) GetState() *State {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+type SpectateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -998,8 +1419,8 @@ type GetRolesRequest struct {
 	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 }
 
-func (x *GetRolesRequest) Reset() {
-	*x = GetRolesRequest{}
+func (x *SpectateRequest) Reset() {
+	*x = SpectateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_jinrou_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1007,13 +1428,13 @@ func (x *GetRolesRequest) Reset() {
 	}
 }
 
-func (x *GetRolesRequest) String() string {
+func (x *SpectateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRolesRequest) ProtoMessage() {}
+func (*SpectateRequest) ProtoMessage() {}
 
-func (x *GetRolesRequest) ProtoReflect() protoreflect.Message {
+func (x *SpectateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_jinrou_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1025,28 +1446,28 @@ func (x *GetRolesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRolesRequest.ProtoReflect.Descriptor instead.
-func (*GetRolesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SpectateRequest.ProtoReflect.Descriptor instead.
+func (*SpectateRequest) Descriptor() ([]byte, []int) {
 	return file_jinrou_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetRolesRequest) GetGameId() string {
+func (x *SpectateRequest) GetGameId() string {
 	if x != nil {
 		return x.GameId
 	}
 	return ""
 }
 
-type GetRolesResponse struct {
+type SpectateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Roles map[uint64]Role `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=jinrou.Role"`
+	State *State `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
 }
 
-func (x *GetRolesResponse) Reset() {
-	*x = GetRolesResponse{}
+func (x *SpectateResponse) Reset() {
+	*x = SpectateResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_jinrou_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1054,13 +1475,13 @@ func (x *GetRolesResponse) Reset() {
 	}
 }
 
-func (x *GetRolesResponse) String() string {
+func (x *SpectateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRolesResponse) ProtoMessage() {}
+func (*SpectateResponse) ProtoMessage() {}
 
-func (x *GetRolesResponse) ProtoReflect() protoreflect.Message {
+func (x *SpectateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_jinrou_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1072,19 +1493,19 @@ func (x *GetRolesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRolesResponse.ProtoReflect.Descriptor instead.
-func (*GetRolesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SpectateResponse.ProtoReflect.Descriptor instead.
+func (*SpectateResponse) Descriptor() ([]byte, []int) {
 	return file_jinrou_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetRolesResponse) GetRoles() map[uint64]Role {
+func (x *SpectateResponse) GetState() *State {
 	if x != nil {
-		return x.Roles
+		return x.State
 	}
 	return nil
 }
 
-type ObserveStateRequest struct {
+type StopSpectatingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1092,8 +1513,8 @@ type ObserveStateRequest struct {
 	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 }
 
-func (x *ObserveStateRequest) Reset() {
-	*x = ObserveStateRequest{}
+func (x *StopSpectatingRequest) Reset() {
+	*x = StopSpectatingRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_jinrou_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1101,13 +1522,13 @@ func (x *ObserveStateRequest) Reset() {
 	}
 }
 
-func (x *ObserveStateRequest) String() string {
+func (x *StopSpectatingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ObserveStateRequest) ProtoMessage() {}
+func (*StopSpectatingRequest) ProtoMessage() {}
 
-func (x *ObserveStateRequest) ProtoReflect() protoreflect.Message {
+func (x *StopSpectatingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_jinrou_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1119,36 +1540,26 @@ func (x *ObserveStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ObserveStateRequest.ProtoReflect.Descriptor instead.
-func (*ObserveStateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StopSpectatingRequest.ProtoReflect.Descriptor instead.
+func (*StopSpectatingRequest) Descriptor() ([]byte, []int) {
 	return file_jinrou_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ObserveStateRequest) GetGameId() string {
+func (x *StopSpectatingRequest) GetGameId() string {
 	if x != nil {
 		return x.GameId
 	}
 	return ""
 }
 
-type ObserveStateResponse struct {
+type StopSpectatingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	State      *State     `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
-	ChangeType ChangeType `protobuf:"varint,2,opt,name=change_type,json=changeType,proto3,enum=jinrou.ChangeType" json:"change_type,omitempty"`
-	OldPhase   Phase      `protobuf:"varint,3,opt,name=old_phase,json=oldPhase,proto3,enum=jinrou.Phase" json:"old_phase,omitempty"`
-	// Types that are assignable to Parameter:
-	//	*ObserveStateResponse_AddedPlayerId
-	//	*ObserveStateResponse_LeftPlayerId
-	//	*ObserveStateResponse_KilledPlayerId
-	//	*ObserveStateResponse_Winner
-	Parameter isObserveStateResponse_Parameter `protobuf_oneof:"parameter"`
 }
 
-func (x *ObserveStateResponse) Reset() {
-	*x = ObserveStateResponse{}
+func (x *StopSpectatingResponse) Reset() {
+	*x = StopSpectatingResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_jinrou_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1156,13 +1567,13 @@ func (x *ObserveStateResponse) Reset() {
 	}
 }
 
-func (x *ObserveStateResponse) String() string {
+func (x *StopSpectatingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ObserveStateResponse) ProtoMessage() {}
+func (*StopSpectatingResponse) ProtoMessage() {}
 
-func (x *ObserveStateResponse) ProtoReflect() protoreflect.Message {
+func (x *StopSpectatingResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_jinrou_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1174,120 +1585,130 @@ func (x *ObserveStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ObserveStateResponse.ProtoReflect.Descriptor instead.
-func (*ObserveStateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StopSpectatingResponse.ProtoReflect.Descriptor instead.
+func (*StopSpectatingResponse) Descriptor() ([]byte, []int) {
 	return file_jinrou_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ObserveStateResponse) GetState() *State {
-	if x != nil {
-		return x.State
-	}
-	return nil
+type VoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId   string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerId uint64 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 }
 
-func (x *ObserveStateResponse) GetChangeType() ChangeType {
-	if x != nil {
-		return x.ChangeType
+func (x *VoteRequest) Reset() {
+	*x = VoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ChangeType_PLAYER_JOINED
 }
 
-func (x *ObserveStateResponse) GetOldPhase() Phase {
-	if x != nil {
-		return x.OldPhase
-	}
-	return Phase_START
+func (x *VoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (m *ObserveStateResponse) GetParameter() isObserveStateResponse_Parameter {
-	if m != nil {
-		return m.Parameter
+func (*VoteRequest) ProtoMessage() {}
+
+func (x *VoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ObserveStateResponse) GetAddedPlayerId() uint64 {
-	if x, ok := x.GetParameter().(*ObserveStateResponse_AddedPlayerId); ok {
-		return x.AddedPlayerId
-	}
-	return 0
+// Deprecated: Use VoteRequest.ProtoReflect.Descriptor instead.
+func (*VoteRequest) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ObserveStateResponse) GetLeftPlayerId() uint64 {
-	if x, ok := x.GetParameter().(*ObserveStateResponse_LeftPlayerId); ok {
-		return x.LeftPlayerId
+func (x *VoteRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
 	}
-	return 0
+	return ""
 }
 
-func (x *ObserveStateResponse) GetKilledPlayerId() uint64 {
-	if x, ok := x.GetParameter().(*ObserveStateResponse_KilledPlayerId); ok {
-		return x.KilledPlayerId
+func (x *VoteRequest) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *ObserveStateResponse) GetWinner() Side {
-	if x, ok := x.GetParameter().(*ObserveStateResponse_Winner); ok {
-		return x.Winner
-	}
-	return Side_NEUTRAL
+type VoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-type isObserveStateResponse_Parameter interface {
-	isObserveStateResponse_Parameter()
+func (x *VoteResponse) Reset() {
+	*x = VoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type ObserveStateResponse_AddedPlayerId struct {
-	AddedPlayerId uint64 `protobuf:"varint,4,opt,name=added_player_id,json=addedPlayerId,proto3,oneof"`
+func (x *VoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type ObserveStateResponse_LeftPlayerId struct {
-	LeftPlayerId uint64 `protobuf:"varint,5,opt,name=left_player_id,json=leftPlayerId,proto3,oneof"`
-}
+func (*VoteResponse) ProtoMessage() {}
 
-type ObserveStateResponse_KilledPlayerId struct {
-	KilledPlayerId uint64 `protobuf:"varint,6,opt,name=killed_player_id,json=killedPlayerId,proto3,oneof"`
+func (x *VoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type ObserveStateResponse_Winner struct {
-	Winner Side `protobuf:"varint,7,opt,name=winner,proto3,enum=jinrou.Side,oneof"`
+// Deprecated: Use VoteResponse.ProtoReflect.Descriptor instead.
+func (*VoteResponse) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{21}
 }
 
-func (*ObserveStateResponse_AddedPlayerId) isObserveStateResponse_Parameter() {}
-
-func (*ObserveStateResponse_LeftPlayerId) isObserveStateResponse_Parameter() {}
-
-func (*ObserveStateResponse_KilledPlayerId) isObserveStateResponse_Parameter() {}
-
-func (*ObserveStateResponse_Winner) isObserveStateResponse_Parameter() {}
-
-type UnobserveStateRequest struct {
+type KillRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	GameId   string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerId uint64 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 }
 
-func (x *UnobserveStateRequest) Reset() {
-	*x = UnobserveStateRequest{}
+func (x *KillRequest) Reset() {
+	*x = KillRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jinrou_proto_msgTypes[20]
+		mi := &file_jinrou_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnobserveStateRequest) String() string {
+func (x *KillRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnobserveStateRequest) ProtoMessage() {}
+func (*KillRequest) ProtoMessage() {}
 
-func (x *UnobserveStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jinrou_proto_msgTypes[20]
+func (x *KillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1298,41 +1719,48 @@ func (x *UnobserveStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnobserveStateRequest.ProtoReflect.Descriptor instead.
-func (*UnobserveStateRequest) Descriptor() ([]byte, []int) {
-	return file_jinrou_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use KillRequest.ProtoReflect.Descriptor instead.
+func (*KillRequest) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *UnobserveStateRequest) GetGameId() string {
+func (x *KillRequest) GetGameId() string {
 	if x != nil {
 		return x.GameId
 	}
 	return ""
 }
 
-type UnobserveStateResponse struct {
+func (x *KillRequest) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+type KillResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UnobserveStateResponse) Reset() {
-	*x = UnobserveStateResponse{}
+func (x *KillResponse) Reset() {
+	*x = KillResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jinrou_proto_msgTypes[21]
+		mi := &file_jinrou_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnobserveStateResponse) String() string {
+func (x *KillResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnobserveStateResponse) ProtoMessage() {}
+func (*KillResponse) ProtoMessage() {}
 
-func (x *UnobserveStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_jinrou_proto_msgTypes[21]
+func (x *KillResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1343,40 +1771,36 @@ func (x *UnobserveStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnobserveStateResponse.ProtoReflect.Descriptor instead.
-func (*UnobserveStateResponse) Descriptor() ([]byte, []int) {
-	return file_jinrou_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use KillResponse.ProtoReflect.Descriptor instead.
+func (*KillResponse) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{23}
 }
 
-type State struct {
+type LockInRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GameId  string             `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	Config  *Config            `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
-	Phase   Phase              `protobuf:"varint,3,opt,name=phase,proto3,enum=jinrou.Phase" json:"phase,omitempty"`
-	Day     int32              `protobuf:"varint,4,opt,name=day,proto3" json:"day,omitempty"`
-	Players map[uint64]*Player `protobuf:"bytes,5,rep,name=players,proto3" json:"players,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 }
 
-func (x *State) Reset() {
-	*x = State{}
+func (x *LockInRequest) Reset() {
+	*x = LockInRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jinrou_proto_msgTypes[22]
+		mi := &file_jinrou_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *State) String() string {
+func (x *LockInRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*State) ProtoMessage() {}
+func (*LockInRequest) ProtoMessage() {}
 
-func (x *State) ProtoReflect() protoreflect.Message {
-	mi := &file_jinrou_proto_msgTypes[22]
+func (x *LockInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1387,72 +1811,81 @@ func (x *State) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use State.ProtoReflect.Descriptor instead.
-func (*State) Descriptor() ([]byte, []int) {
-	return file_jinrou_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use LockInRequest.ProtoReflect.Descriptor instead.
+func (*LockInRequest) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *State) GetGameId() string {
+func (x *LockInRequest) GetGameId() string {
 	if x != nil {
 		return x.GameId
 	}
 	return ""
 }
 
-func (x *State) GetConfig() *Config {
-	if x != nil {
-		return x.Config
-	}
-	return nil
+type LockInResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *State) GetPhase() Phase {
-	if x != nil {
-		return x.Phase
+func (x *LockInResponse) Reset() {
+	*x = LockInResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return Phase_START
 }
 
-func (x *State) GetDay() int32 {
-	if x != nil {
-		return x.Day
-	}
-	return 0
+func (x *LockInResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *State) GetPlayers() map[uint64]*Player {
-	if x != nil {
-		return x.Players
+func (*LockInResponse) ProtoMessage() {}
+
+func (x *LockInResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type Config struct {
+// Deprecated: Use LockInResponse.ProtoReflect.Descriptor instead.
+func (*LockInResponse) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{25}
+}
+
+type RetractVoteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PlayerNum   int32 `protobuf:"varint,1,opt,name=player_num,json=playerNum,proto3" json:"player_num,omitempty"`
-	WerewolfNum int32 `protobuf:"varint,2,opt,name=werewolf_num,json=werewolfNum,proto3" json:"werewolf_num,omitempty"`
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 }
 
-func (x *Config) Reset() {
-	*x = Config{}
+func (x *RetractVoteRequest) Reset() {
+	*x = RetractVoteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jinrou_proto_msgTypes[23]
+		mi := &file_jinrou_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Config) String() string {
+func (x *RetractVoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Config) ProtoMessage() {}
+func (*RetractVoteRequest) ProtoMessage() {}
 
-func (x *Config) ProtoReflect() protoreflect.Message {
-	mi := &file_jinrou_proto_msgTypes[23]
+func (x *RetractVoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1463,53 +1896,81 @@ func (x *Config) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Config.ProtoReflect.Descriptor instead.
-func (*Config) Descriptor() ([]byte, []int) {
-	return file_jinrou_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use RetractVoteRequest.ProtoReflect.Descriptor instead.
+func (*RetractVoteRequest) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *Config) GetPlayerNum() int32 {
+func (x *RetractVoteRequest) GetGameId() string {
 	if x != nil {
-		return x.PlayerNum
+		return x.GameId
 	}
-	return 0
+	return ""
 }
 
-func (x *Config) GetWerewolfNum() int32 {
-	if x != nil {
-		return x.WerewolfNum
+type RetractVoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RetractVoteResponse) Reset() {
+	*x = RetractVoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-type Player struct {
+func (x *RetractVoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetractVoteResponse) ProtoMessage() {}
+
+func (x *RetractVoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetractVoteResponse.ProtoReflect.Descriptor instead.
+func (*RetractVoteResponse) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{27}
+}
+
+type GetMyVoteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PlayerId   uint64 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	PlayerName string `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
-	IsDied     bool   `protobuf:"varint,3,opt,name=is_died,json=isDied,proto3" json:"is_died,omitempty"`
-	Index      int32  `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 }
 
-func (x *Player) Reset() {
-	*x = Player{}
+func (x *GetMyVoteRequest) Reset() {
+	*x = GetMyVoteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jinrou_proto_msgTypes[24]
+		mi := &file_jinrou_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Player) String() string {
+func (x *GetMyVoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Player) ProtoMessage() {}
+func (*GetMyVoteRequest) ProtoMessage() {}
 
-func (x *Player) ProtoReflect() protoreflect.Message {
-	mi := &file_jinrou_proto_msgTypes[24]
+func (x *GetMyVoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1520,327 +1981,3505 @@ func (x *Player) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Player.ProtoReflect.Descriptor instead.
-func (*Player) Descriptor() ([]byte, []int) {
-	return file_jinrou_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use GetMyVoteRequest.ProtoReflect.Descriptor instead.
+func (*GetMyVoteRequest) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *Player) GetPlayerId() uint64 {
+func (x *GetMyVoteRequest) GetGameId() string {
 	if x != nil {
-		return x.PlayerId
+		return x.GameId
 	}
-	return 0
+	return ""
 }
 
-func (x *Player) GetPlayerName() string {
-	if x != nil {
-		return x.PlayerName
+type GetMyVoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Voted          bool   `protobuf:"varint,1,opt,name=voted,proto3" json:"voted,omitempty"`
+	TargetPlayerId uint64 `protobuf:"varint,2,opt,name=target_player_id,json=targetPlayerId,proto3" json:"target_player_id,omitempty"`
+	Locked         bool   `protobuf:"varint,3,opt,name=locked,proto3" json:"locked,omitempty"`
+}
+
+func (x *GetMyVoteResponse) Reset() {
+	*x = GetMyVoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Player) GetIsDied() bool {
+func (x *GetMyVoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyVoteResponse) ProtoMessage() {}
+
+func (x *GetMyVoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyVoteResponse.ProtoReflect.Descriptor instead.
+func (*GetMyVoteResponse) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetMyVoteResponse) GetVoted() bool {
 	if x != nil {
-		return x.IsDied
+		return x.Voted
 	}
 	return false
 }
 
-func (x *Player) GetIndex() int32 {
+func (x *GetMyVoteResponse) GetTargetPlayerId() uint64 {
 	if x != nil {
-		return x.Index
+		return x.TargetPlayerId
 	}
 	return 0
 }
 
-var File_jinrou_proto protoreflect.FileDescriptor
-
-var file_jinrou_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06,
-	0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x22, 0x32, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x6a, 0x0a, 0x10, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b,
-	0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
-	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x35, 0x0a, 0x0e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72,
-	0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4c, 0x0a,
-	0x0f, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
-	0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
-	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3b, 0x0a, 0x11, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x39, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
-	0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x22, 0x26, 0x0a, 0x0b, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x0c, 0x4a,
-	0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6a, 0x69, 0x6e,
-	0x72, 0x6f, 0x75, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x22, 0x27, 0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x34, 0x0a, 0x0d, 0x4c, 0x65, 0x61,
-	0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6a, 0x69, 0x6e, 0x72,
-	0x6f, 0x75, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22,
-	0x43, 0x0a, 0x0b, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
-	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x49, 0x64, 0x22, 0x0e, 0x0a, 0x0c, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x0a, 0x0b, 0x4b, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
-	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x0e, 0x0a, 0x0c, 0x4b, 0x69, 0x6c,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x0a, 0x0b, 0x4e, 0x65, 0x78,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49,
-	0x64, 0x22, 0x0e, 0x0a, 0x0c, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x2a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x95, 0x01,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x39, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x23, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f,
-	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x1a, 0x46, 0x0a,
-	0x0a, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x6a,
-	0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2e, 0x0a, 0x13, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67,
-	0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0xcf, 0x02, 0x0a, 0x14, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
-	0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f,
-	0x75, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x5f,
-	0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6a, 0x69,
-	0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x50,
-	0x68, 0x61, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x70, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52,
-	0x0d, 0x61, 0x64, 0x64, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26,
-	0x0a, 0x0e, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0c, 0x6c, 0x65, 0x66, 0x74, 0x50, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x64,
-	0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
-	0x48, 0x00, 0x52, 0x0e, 0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x53, 0x69, 0x64, 0x65,
-	0x48, 0x00, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x42, 0x0b, 0x0a, 0x09, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x22, 0x30, 0x0a, 0x15, 0x55, 0x6e, 0x6f, 0x62, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x55, 0x6e, 0x6f,
-	0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x81, 0x02, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a,
-	0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x23,
-	0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e,
-	0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68,
-	0x61, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x34, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x1a, 0x4a, 0x0a, 0x0c, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6a,
-	0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4a, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x75, 0x6d, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x75, 0x6d,
-	0x12, 0x21, 0x0a, 0x0c, 0x77, 0x65, 0x72, 0x65, 0x77, 0x6f, 0x6c, 0x66, 0x5f, 0x6e, 0x75, 0x6d,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x77, 0x65, 0x72, 0x65, 0x77, 0x6f, 0x6c, 0x66,
-	0x4e, 0x75, 0x6d, 0x22, 0x75, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1b, 0x0a,
-	0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69,
-	0x73, 0x5f, 0x64, 0x69, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73,
-	0x44, 0x69, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2a, 0x30, 0x0a, 0x05, 0x50, 0x68,
-	0x61, 0x73, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x00, 0x12, 0x08,
-	0x0a, 0x04, 0x4e, 0x4f, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x49, 0x47, 0x48,
-	0x54, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x45, 0x4e, 0x44, 0x10, 0x03, 0x2a, 0x32, 0x0a, 0x04,
-	0x53, 0x69, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x45, 0x55, 0x54, 0x52, 0x41, 0x4c, 0x10,
-	0x00, 0x12, 0x0d, 0x0a, 0x09, 0x56, 0x49, 0x4c, 0x4c, 0x41, 0x47, 0x45, 0x52, 0x53, 0x10, 0x01,
-	0x12, 0x0e, 0x0a, 0x0a, 0x57, 0x45, 0x52, 0x45, 0x57, 0x4f, 0x4c, 0x56, 0x45, 0x53, 0x10, 0x02,
-	0x2a, 0x2e, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x4e, 0x4b, 0x4f,
-	0x57, 0x4e, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x56, 0x49, 0x4c, 0x4c, 0x41, 0x47, 0x45, 0x52,
-	0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x57, 0x45, 0x52, 0x45, 0x57, 0x4f, 0x4c, 0x46, 0x10, 0x02,
-	0x2a, 0x75, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11,
-	0x0a, 0x0d, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x4c, 0x45, 0x46, 0x54,
-	0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e,
-	0x47, 0x45, 0x44, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x43,
-	0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x4f, 0x55, 0x54, 0x5f, 0x4b,
-	0x49, 0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x47, 0x41, 0x4d, 0x45,
-	0x5f, 0x4f, 0x56, 0x45, 0x52, 0x10, 0x04, 0x32, 0xa7, 0x05, 0x0a, 0x06, 0x4a, 0x69, 0x6e, 0x72,
-	0x6f, 0x75, 0x12, 0x3d, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x17,
-	0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75,
-	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3a, 0x0a, 0x07, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x16, 0x2e, 0x6a,
-	0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x52, 0x65,
-	0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a,
-	0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x2e, 0x6a, 0x69,
-	0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x13, 0x2e, 0x6a, 0x69, 0x6e,
-	0x72, 0x6f, 0x75, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x14, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x14,
-	0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x4c, 0x65,
-	0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x56,
-	0x6f, 0x74, 0x65, 0x12, 0x13, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x56, 0x6f, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f,
-	0x75, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
-	0x0a, 0x04, 0x4b, 0x69, 0x6c, 0x6c, 0x12, 0x13, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e,
-	0x4b, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6a, 0x69,
-	0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x31, 0x0a, 0x04, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x13, 0x2e, 0x6a, 0x69, 0x6e, 0x72,
-	0x6f, 0x75, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
-	0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73,
-	0x12, 0x17, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6a, 0x69, 0x6e, 0x72,
-	0x6f, 0x75, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x4f, 0x62, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1c, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01,
-	0x12, 0x4f, 0x0a, 0x0e, 0x55, 0x6e, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x1d, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x55, 0x6e, 0x6f, 0x62,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1e, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x55, 0x6e, 0x6f, 0x62, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+func (x *GetMyVoteResponse) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
 }
 
-var (
-	file_jinrou_proto_rawDescOnce sync.Once
-	file_jinrou_proto_rawDescData = file_jinrou_proto_rawDesc
-)
+type DivineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_jinrou_proto_rawDescGZIP() []byte {
-	file_jinrou_proto_rawDescOnce.Do(func() {
-		file_jinrou_proto_rawDescData = protoimpl.X.CompressGZIP(file_jinrou_proto_rawDescData)
-	})
-	return file_jinrou_proto_rawDescData
+	GameId   string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerId uint64 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 }
 
-var file_jinrou_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_jinrou_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
-var file_jinrou_proto_goTypes = []interface{}{
-	(Phase)(0),                     // 0: jinrou.Phase
-	(Side)(0),                      // 1: jinrou.Side
-	(Role)(0),                      // 2: jinrou.Role
-	(ChangeType)(0),                // 3: jinrou.ChangeType
-	(*RegisterRequest)(nil),        // 4: jinrou.RegisterRequest
-	(*RegisterResponse)(nil),       // 5: jinrou.RegisterResponse
-	(*RefreshRequest)(nil),         // 6: jinrou.RefreshRequest
-	(*RefreshResponse)(nil),        // 7: jinrou.RefreshResponse
-	(*CreateGameRequest)(nil),      // 8: jinrou.CreateGameRequest
-	(*CreateGameResponse)(nil),     // 9: jinrou.CreateGameResponse
-	(*JoinRequest)(nil),            // 10: jinrou.JoinRequest
-	(*JoinResponse)(nil),           // 11: jinrou.JoinResponse
-	(*LeaveRequest)(nil),           // 12: jinrou.LeaveRequest
-	(*LeaveResponse)(nil),          // 13: jinrou.LeaveResponse
-	(*VoteRequest)(nil),            // 14: jinrou.VoteRequest
-	(*VoteResponse)(nil),           // 15: jinrou.VoteResponse
-	(*KillRequest)(nil),            // 16: jinrou.KillRequest
-	(*KillResponse)(nil),           // 17: jinrou.KillResponse
-	(*NextRequest)(nil),            // 18: jinrou.NextRequest
-	(*NextResponse)(nil),           // 19: jinrou.NextResponse
-	(*GetRolesRequest)(nil),        // 20: jinrou.GetRolesRequest
-	(*GetRolesResponse)(nil),       // 21: jinrou.GetRolesResponse
-	(*ObserveStateRequest)(nil),    // 22: jinrou.ObserveStateRequest
-	(*ObserveStateResponse)(nil),   // 23: jinrou.ObserveStateResponse
-	(*UnobserveStateRequest)(nil),  // 24: jinrou.UnobserveStateRequest
-	(*UnobserveStateResponse)(nil), // 25: jinrou.UnobserveStateResponse
-	(*State)(nil),                  // 26: jinrou.State
-	(*Config)(nil),                 // 27: jinrou.Config
-	(*Player)(nil),                 // 28: jinrou.Player
-	nil,                            // 29: jinrou.GetRolesResponse.RolesEntry
-	nil,                            // 30: jinrou.State.PlayersEntry
+func (x *DivineRequest) Reset() {
+	*x = DivineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_jinrou_proto_depIdxs = []int32{
-	27, // 0: jinrou.CreateGameRequest.config:type_name -> jinrou.Config
-	26, // 1: jinrou.CreateGameResponse.state:type_name -> jinrou.State
-	26, // 2: jinrou.JoinResponse.state:type_name -> jinrou.State
-	26, // 3: jinrou.LeaveResponse.state:type_name -> jinrou.State
-	29, // 4: jinrou.GetRolesResponse.roles:type_name -> jinrou.GetRolesResponse.RolesEntry
-	26, // 5: jinrou.ObserveStateResponse.state:type_name -> jinrou.State
-	3,  // 6: jinrou.ObserveStateResponse.change_type:type_name -> jinrou.ChangeType
-	0,  // 7: jinrou.ObserveStateResponse.old_phase:type_name -> jinrou.Phase
-	1,  // 8: jinrou.ObserveStateResponse.winner:type_name -> jinrou.Side
-	27, // 9: jinrou.State.config:type_name -> jinrou.Config
-	0,  // 10: jinrou.State.phase:type_name -> jinrou.Phase
-	30, // 11: jinrou.State.players:type_name -> jinrou.State.PlayersEntry
-	2,  // 12: jinrou.GetRolesResponse.RolesEntry.value:type_name -> jinrou.Role
-	28, // 13: jinrou.State.PlayersEntry.value:type_name -> jinrou.Player
-	4,  // 14: jinrou.Jinrou.Register:input_type -> jinrou.RegisterRequest
-	6,  // 15: jinrou.Jinrou.Refresh:input_type -> jinrou.RefreshRequest
-	8,  // 16: jinrou.Jinrou.CreateGame:input_type -> jinrou.CreateGameRequest
-	10, // 17: jinrou.Jinrou.Join:input_type -> jinrou.JoinRequest
-	12, // 18: jinrou.Jinrou.Leave:input_type -> jinrou.LeaveRequest
-	14, // 19: jinrou.Jinrou.Vote:input_type -> jinrou.VoteRequest
-	16, // 20: jinrou.Jinrou.Kill:input_type -> jinrou.KillRequest
-	18, // 21: jinrou.Jinrou.Next:input_type -> jinrou.NextRequest
-	20, // 22: jinrou.Jinrou.GetRoles:input_type -> jinrou.GetRolesRequest
-	22, // 23: jinrou.Jinrou.ObserveState:input_type -> jinrou.ObserveStateRequest
-	24, // 24: jinrou.Jinrou.UnobserveState:input_type -> jinrou.UnobserveStateRequest
-	5,  // 25: jinrou.Jinrou.Register:output_type -> jinrou.RegisterResponse
-	7,  // 26: jinrou.Jinrou.Refresh:output_type -> jinrou.RefreshResponse
-	9,  // 27: jinrou.Jinrou.CreateGame:output_type -> jinrou.CreateGameResponse
-	11, // 28: jinrou.Jinrou.Join:output_type -> jinrou.JoinResponse
-	13, // 29: jinrou.Jinrou.Leave:output_type -> jinrou.LeaveResponse
-	15, // 30: jinrou.Jinrou.Vote:output_type -> jinrou.VoteResponse
-	17, // 31: jinrou.Jinrou.Kill:output_type -> jinrou.KillResponse
-	19, // 32: jinrou.Jinrou.Next:output_type -> jinrou.NextResponse
-	21, // 33: jinrou.Jinrou.GetRoles:output_type -> jinrou.GetRolesResponse
-	23, // 34: jinrou.Jinrou.ObserveState:output_type -> jinrou.ObserveStateResponse
-	25, // 35: jinrou.Jinrou.UnobserveState:output_type -> jinrou.UnobserveStateResponse
-	25, // [25:36] is the sub-list for method output_type
-	14, // [14:25] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+
+func (x *DivineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_jinrou_proto_init() }
-func file_jinrou_proto_init() {
-	if File_jinrou_proto != nil {
-		return
+func (*DivineRequest) ProtoMessage() {}
+
+func (x *DivineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_jinrou_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterRequest); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DivineRequest.ProtoReflect.Descriptor instead.
+func (*DivineRequest) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DivineRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *DivineRequest) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+type DivineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Side Side `protobuf:"varint,1,opt,name=side,proto3,enum=jinrou.Side" json:"side,omitempty"`
+}
+
+func (x *DivineResponse) Reset() {
+	*x = DivineResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DivineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DivineResponse) ProtoMessage() {}
+
+func (x *DivineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DivineResponse.ProtoReflect.Descriptor instead.
+func (*DivineResponse) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DivineResponse) GetSide() Side {
+	if x != nil {
+		return x.Side
+	}
+	return Side_NEUTRAL
+}
+
+type GuardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId   string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerId uint64 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+}
+
+func (x *GuardRequest) Reset() {
+	*x = GuardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuardRequest) ProtoMessage() {}
+
+func (x *GuardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuardRequest.ProtoReflect.Descriptor instead.
+func (*GuardRequest) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GuardRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GuardRequest) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+type GuardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GuardResponse) Reset() {
+	*x = GuardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuardResponse) ProtoMessage() {}
+
+func (x *GuardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuardResponse.ProtoReflect.Descriptor instead.
+func (*GuardResponse) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{33}
+}
+
+type GetMediumResultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *GetMediumResultRequest) Reset() {
+	*x = GetMediumResultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMediumResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMediumResultRequest) ProtoMessage() {}
+
+func (x *GetMediumResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMediumResultRequest.ProtoReflect.Descriptor instead.
+func (*GetMediumResultRequest) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetMediumResultRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type GetMediumResultResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExecutedPlayerId uint64 `protobuf:"varint,1,opt,name=executed_player_id,json=executedPlayerId,proto3" json:"executed_player_id,omitempty"`
+	Side             Side   `protobuf:"varint,2,opt,name=side,proto3,enum=jinrou.Side" json:"side,omitempty"`
+}
+
+func (x *GetMediumResultResponse) Reset() {
+	*x = GetMediumResultResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMediumResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMediumResultResponse) ProtoMessage() {}
+
+func (x *GetMediumResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMediumResultResponse.ProtoReflect.Descriptor instead.
+func (*GetMediumResultResponse) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetMediumResultResponse) GetExecutedPlayerId() uint64 {
+	if x != nil {
+		return x.ExecutedPlayerId
+	}
+	return 0
+}
+
+func (x *GetMediumResultResponse) GetSide() Side {
+	if x != nil {
+		return x.Side
+	}
+	return Side_NEUTRAL
+}
+
+type ShootRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId   string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerId uint64 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+}
+
+func (x *ShootRequest) Reset() {
+	*x = ShootRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShootRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShootRequest) ProtoMessage() {}
+
+func (x *ShootRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShootRequest.ProtoReflect.Descriptor instead.
+func (*ShootRequest) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ShootRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ShootRequest) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+type ShootResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ShootResponse) Reset() {
+	*x = ShootResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShootResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShootResponse) ProtoMessage() {}
+
+func (x *ShootResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShootResponse.ProtoReflect.Descriptor instead.
+func (*ShootResponse) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{37}
+}
+
+type BindRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId         string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	FirstPlayerId  uint64 `protobuf:"varint,2,opt,name=first_player_id,json=firstPlayerId,proto3" json:"first_player_id,omitempty"`
+	SecondPlayerId uint64 `protobuf:"varint,3,opt,name=second_player_id,json=secondPlayerId,proto3" json:"second_player_id,omitempty"`
+}
+
+func (x *BindRequest) Reset() {
+	*x = BindRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BindRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BindRequest) ProtoMessage() {}
+
+func (x *BindRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BindRequest.ProtoReflect.Descriptor instead.
+func (*BindRequest) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BindRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *BindRequest) GetFirstPlayerId() uint64 {
+	if x != nil {
+		return x.FirstPlayerId
+	}
+	return 0
+}
+
+func (x *BindRequest) GetSecondPlayerId() uint64 {
+	if x != nil {
+		return x.SecondPlayerId
+	}
+	return 0
+}
+
+type BindResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BindResponse) Reset() {
+	*x = BindResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BindResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BindResponse) ProtoMessage() {}
+
+func (x *BindResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BindResponse.ProtoReflect.Descriptor instead.
+func (*BindResponse) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{39}
+}
+
+type NextRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *NextRequest) Reset() {
+	*x = NextRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextRequest) ProtoMessage() {}
+
+func (x *NextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextRequest.ProtoReflect.Descriptor instead.
+func (*NextRequest) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *NextRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type NextResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NextResponse) Reset() {
+	*x = NextResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NextResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextResponse) ProtoMessage() {}
+
+func (x *NextResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextResponse.ProtoReflect.Descriptor instead.
+func (*NextResponse) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{41}
+}
+
+type ForfeitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *ForfeitRequest) Reset() {
+	*x = ForfeitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForfeitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForfeitRequest) ProtoMessage() {}
+
+func (x *ForfeitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForfeitRequest.ProtoReflect.Descriptor instead.
+func (*ForfeitRequest) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ForfeitRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type ForfeitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ForfeitResponse) Reset() {
+	*x = ForfeitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForfeitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForfeitResponse) ProtoMessage() {}
+
+func (x *ForfeitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForfeitResponse.ProtoReflect.Descriptor instead.
+func (*ForfeitResponse) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{43}
+}
+
+type GetRolesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *GetRolesRequest) Reset() {
+	*x = GetRolesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRolesRequest) ProtoMessage() {}
+
+func (x *GetRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRolesRequest.ProtoReflect.Descriptor instead.
+func (*GetRolesRequest) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetRolesRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type GetRolesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Roles map[uint64]Role `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=jinrou.Role"`
+}
+
+func (x *GetRolesResponse) Reset() {
+	*x = GetRolesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRolesResponse) ProtoMessage() {}
+
+func (x *GetRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRolesResponse.ProtoReflect.Descriptor instead.
+func (*GetRolesResponse) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetRolesResponse) GetRoles() map[uint64]Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type GetLoverRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *GetLoverRequest) Reset() {
+	*x = GetLoverRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLoverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoverRequest) ProtoMessage() {}
+
+func (x *GetLoverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoverRequest.ProtoReflect.Descriptor instead.
+func (*GetLoverRequest) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetLoverRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type GetLoverResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoverPlayerId uint64 `protobuf:"varint,1,opt,name=lover_player_id,json=loverPlayerId,proto3" json:"lover_player_id,omitempty"`
+}
+
+func (x *GetLoverResponse) Reset() {
+	*x = GetLoverResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLoverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoverResponse) ProtoMessage() {}
+
+func (x *GetLoverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoverResponse.ProtoReflect.Descriptor instead.
+func (*GetLoverResponse) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetLoverResponse) GetLoverPlayerId() uint64 {
+	if x != nil {
+		return x.LoverPlayerId
+	}
+	return 0
+}
+
+type ObserveStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId           string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	LastSeenSequence uint64 `protobuf:"varint,2,opt,name=last_seen_sequence,json=lastSeenSequence,proto3" json:"last_seen_sequence,omitempty"`
+}
+
+func (x *ObserveStateRequest) Reset() {
+	*x = ObserveStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObserveStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObserveStateRequest) ProtoMessage() {}
+
+func (x *ObserveStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObserveStateRequest.ProtoReflect.Descriptor instead.
+func (*ObserveStateRequest) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ObserveStateRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ObserveStateRequest) GetLastSeenSequence() uint64 {
+	if x != nil {
+		return x.LastSeenSequence
+	}
+	return 0
+}
+
+type ObserveStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State      *State     `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	ChangeType ChangeType `protobuf:"varint,2,opt,name=change_type,json=changeType,proto3,enum=jinrou.ChangeType" json:"change_type,omitempty"`
+	OldPhase   Phase      `protobuf:"varint,3,opt,name=old_phase,json=oldPhase,proto3,enum=jinrou.Phase" json:"old_phase,omitempty"`
+	// Types that are assignable to Parameter:
+	//	*ObserveStateResponse_AddedPlayerId
+	//	*ObserveStateResponse_LeftPlayerId
+	//	*ObserveStateResponse_KilledPlayerId
+	//	*ObserveStateResponse_Winner
+	//	*ObserveStateResponse_ForfeitedPlayerId
+	Parameter isObserveStateResponse_Parameter `protobuf_oneof:"parameter"`
+	Deaths    []*Death                         `protobuf:"bytes,8,rep,name=deaths,proto3" json:"deaths,omitempty"`
+	Sequence  uint64                           `protobuf:"varint,10,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Votes     map[uint64]uint64                `protobuf:"bytes,11,rep,name=votes,proto3" json:"votes,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *ObserveStateResponse) Reset() {
+	*x = ObserveStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObserveStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObserveStateResponse) ProtoMessage() {}
+
+func (x *ObserveStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObserveStateResponse.ProtoReflect.Descriptor instead.
+func (*ObserveStateResponse) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ObserveStateResponse) GetState() *State {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *ObserveStateResponse) GetChangeType() ChangeType {
+	if x != nil {
+		return x.ChangeType
+	}
+	return ChangeType_PLAYER_JOINED
+}
+
+func (x *ObserveStateResponse) GetOldPhase() Phase {
+	if x != nil {
+		return x.OldPhase
+	}
+	return Phase_START
+}
+
+func (m *ObserveStateResponse) GetParameter() isObserveStateResponse_Parameter {
+	if m != nil {
+		return m.Parameter
+	}
+	return nil
+}
+
+func (x *ObserveStateResponse) GetAddedPlayerId() uint64 {
+	if x, ok := x.GetParameter().(*ObserveStateResponse_AddedPlayerId); ok {
+		return x.AddedPlayerId
+	}
+	return 0
+}
+
+func (x *ObserveStateResponse) GetLeftPlayerId() uint64 {
+	if x, ok := x.GetParameter().(*ObserveStateResponse_LeftPlayerId); ok {
+		return x.LeftPlayerId
+	}
+	return 0
+}
+
+func (x *ObserveStateResponse) GetKilledPlayerId() uint64 {
+	if x, ok := x.GetParameter().(*ObserveStateResponse_KilledPlayerId); ok {
+		return x.KilledPlayerId
+	}
+	return 0
+}
+
+func (x *ObserveStateResponse) GetWinner() Side {
+	if x, ok := x.GetParameter().(*ObserveStateResponse_Winner); ok {
+		return x.Winner
+	}
+	return Side_NEUTRAL
+}
+
+func (x *ObserveStateResponse) GetForfeitedPlayerId() uint64 {
+	if x, ok := x.GetParameter().(*ObserveStateResponse_ForfeitedPlayerId); ok {
+		return x.ForfeitedPlayerId
+	}
+	return 0
+}
+
+func (x *ObserveStateResponse) GetDeaths() []*Death {
+	if x != nil {
+		return x.Deaths
+	}
+	return nil
+}
+
+func (x *ObserveStateResponse) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ObserveStateResponse) GetVotes() map[uint64]uint64 {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+type isObserveStateResponse_Parameter interface {
+	isObserveStateResponse_Parameter()
+}
+
+type ObserveStateResponse_AddedPlayerId struct {
+	AddedPlayerId uint64 `protobuf:"varint,4,opt,name=added_player_id,json=addedPlayerId,proto3,oneof"`
+}
+
+type ObserveStateResponse_LeftPlayerId struct {
+	LeftPlayerId uint64 `protobuf:"varint,5,opt,name=left_player_id,json=leftPlayerId,proto3,oneof"`
+}
+
+type ObserveStateResponse_KilledPlayerId struct {
+	KilledPlayerId uint64 `protobuf:"varint,6,opt,name=killed_player_id,json=killedPlayerId,proto3,oneof"`
+}
+
+type ObserveStateResponse_Winner struct {
+	Winner Side `protobuf:"varint,7,opt,name=winner,proto3,enum=jinrou.Side,oneof"`
+}
+
+type ObserveStateResponse_ForfeitedPlayerId struct {
+	ForfeitedPlayerId uint64 `protobuf:"varint,9,opt,name=forfeited_player_id,json=forfeitedPlayerId,proto3,oneof"`
+}
+
+func (*ObserveStateResponse_AddedPlayerId) isObserveStateResponse_Parameter() {}
+
+func (*ObserveStateResponse_LeftPlayerId) isObserveStateResponse_Parameter() {}
+
+func (*ObserveStateResponse_KilledPlayerId) isObserveStateResponse_Parameter() {}
+
+func (*ObserveStateResponse_Winner) isObserveStateResponse_Parameter() {}
+
+func (*ObserveStateResponse_ForfeitedPlayerId) isObserveStateResponse_Parameter() {}
+
+type Death struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId uint64     `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Cause    DeathCause `protobuf:"varint,2,opt,name=cause,proto3,enum=jinrou.DeathCause" json:"cause,omitempty"`
+}
+
+func (x *Death) Reset() {
+	*x = Death{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Death) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Death) ProtoMessage() {}
+
+func (x *Death) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Death.ProtoReflect.Descriptor instead.
+func (*Death) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Death) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *Death) GetCause() DeathCause {
+	if x != nil {
+		return x.Cause
+	}
+	return DeathCause_EXECUTED
+}
+
+type UnobserveStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *UnobserveStateRequest) Reset() {
+	*x = UnobserveStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnobserveStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnobserveStateRequest) ProtoMessage() {}
+
+func (x *UnobserveStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnobserveStateRequest.ProtoReflect.Descriptor instead.
+func (*UnobserveStateRequest) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UnobserveStateRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type UnobserveStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnobserveStateResponse) Reset() {
+	*x = UnobserveStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnobserveStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnobserveStateResponse) ProtoMessage() {}
+
+func (x *UnobserveStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnobserveStateResponse.ProtoReflect.Descriptor instead.
+func (*UnobserveStateResponse) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{52}
+}
+
+type SendMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Text   string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *SendMessageRequest) Reset() {
+	*x = SendMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageRequest) ProtoMessage() {}
+
+func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendMessageRequest) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SendMessageRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type SendMessageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SendMessageResponse) Reset() {
+	*x = SendMessageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageResponse) ProtoMessage() {}
+
+func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendMessageResponse) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{54}
+}
+
+type ObserveMessagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *ObserveMessagesRequest) Reset() {
+	*x = ObserveMessagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObserveMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObserveMessagesRequest) ProtoMessage() {}
+
+func (x *ObserveMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObserveMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ObserveMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ObserveMessagesRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type ObserveMessagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ObserveMessagesResponse) Reset() {
+	*x = ObserveMessagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObserveMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObserveMessagesResponse) ProtoMessage() {}
+
+func (x *ObserveMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObserveMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ObserveMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ObserveMessagesResponse) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type UnobserveMessagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *UnobserveMessagesRequest) Reset() {
+	*x = UnobserveMessagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnobserveMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnobserveMessagesRequest) ProtoMessage() {}
+
+func (x *UnobserveMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnobserveMessagesRequest.ProtoReflect.Descriptor instead.
+func (*UnobserveMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *UnobserveMessagesRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type UnobserveMessagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnobserveMessagesResponse) Reset() {
+	*x = UnobserveMessagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnobserveMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnobserveMessagesResponse) ProtoMessage() {}
+
+func (x *UnobserveMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnobserveMessagesResponse.ProtoReflect.Descriptor instead.
+func (*UnobserveMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{58}
+}
+
+type ObservePrivateEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *ObservePrivateEventsRequest) Reset() {
+	*x = ObservePrivateEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObservePrivateEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObservePrivateEventsRequest) ProtoMessage() {}
+
+func (x *ObservePrivateEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObservePrivateEventsRequest.ProtoReflect.Descriptor instead.
+func (*ObservePrivateEventsRequest) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ObservePrivateEventsRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type ObservePrivateEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event *PrivateEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *ObservePrivateEventsResponse) Reset() {
+	*x = ObservePrivateEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObservePrivateEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObservePrivateEventsResponse) ProtoMessage() {}
+
+func (x *ObservePrivateEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObservePrivateEventsResponse.ProtoReflect.Descriptor instead.
+func (*ObservePrivateEventsResponse) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ObservePrivateEventsResponse) GetEvent() *PrivateEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type UnobservePrivateEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *UnobservePrivateEventsRequest) Reset() {
+	*x = UnobservePrivateEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnobservePrivateEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnobservePrivateEventsRequest) ProtoMessage() {}
+
+func (x *UnobservePrivateEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnobservePrivateEventsRequest.ProtoReflect.Descriptor instead.
+func (*UnobservePrivateEventsRequest) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *UnobservePrivateEventsRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type UnobservePrivateEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnobservePrivateEventsResponse) Reset() {
+	*x = UnobservePrivateEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnobservePrivateEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnobservePrivateEventsResponse) ProtoMessage() {}
+
+func (x *UnobservePrivateEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnobservePrivateEventsResponse.ProtoReflect.Descriptor instead.
+func (*UnobservePrivateEventsResponse) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{62}
+}
+
+type State struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId             string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Config             *Config                `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Phase              Phase                  `protobuf:"varint,3,opt,name=phase,proto3,enum=jinrou.Phase" json:"phase,omitempty"`
+	Day                int32                  `protobuf:"varint,4,opt,name=day,proto3" json:"day,omitempty"`
+	Players            map[uint64]*Player     `protobuf:"bytes,5,rep,name=players,proto3" json:"players,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Deadline           *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	OwnerId            uint64                 `protobuf:"varint,7,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	RunoffCandidateIds []uint64               `protobuf:"varint,8,rep,packed,name=runoff_candidate_ids,json=runoffCandidateIds,proto3" json:"runoff_candidate_ids,omitempty"`
+}
+
+func (x *State) Reset() {
+	*x = State{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *State) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*State) ProtoMessage() {}
+
+func (x *State) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use State.ProtoReflect.Descriptor instead.
+func (*State) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *State) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *State) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *State) GetPhase() Phase {
+	if x != nil {
+		return x.Phase
+	}
+	return Phase_START
+}
+
+func (x *State) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *State) GetPlayers() map[uint64]*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *State) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *State) GetOwnerId() uint64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *State) GetRunoffCandidateIds() []uint64 {
+	if x != nil {
+		return x.RunoffCandidateIds
+	}
+	return nil
+}
+
+type Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerNum             int32             `protobuf:"varint,1,opt,name=player_num,json=playerNum,proto3" json:"player_num,omitempty"`
+	WerewolfNum           int32             `protobuf:"varint,2,opt,name=werewolf_num,json=werewolfNum,proto3" json:"werewolf_num,omitempty"`
+	AllowConsecutiveGuard bool              `protobuf:"varint,5,opt,name=allow_consecutive_guard,json=allowConsecutiveGuard,proto3" json:"allow_consecutive_guard,omitempty"`
+	RoleNums              []*RoleNum        `protobuf:"bytes,7,rep,name=role_nums,json=roleNums,proto3" json:"role_nums,omitempty"`
+	DiscussionSeconds     int32             `protobuf:"varint,8,opt,name=discussion_seconds,json=discussionSeconds,proto3" json:"discussion_seconds,omitempty"`
+	VotingSeconds         int32             `protobuf:"varint,9,opt,name=voting_seconds,json=votingSeconds,proto3" json:"voting_seconds,omitempty"`
+	NightSeconds          int32             `protobuf:"varint,10,opt,name=night_seconds,json=nightSeconds,proto3" json:"night_seconds,omitempty"`
+	RandomKillOnTimeout   bool              `protobuf:"varint,11,opt,name=random_kill_on_timeout,json=randomKillOnTimeout,proto3" json:"random_kill_on_timeout,omitempty"`
+	SpectatorsSeeRoles    bool              `protobuf:"varint,12,opt,name=spectators_see_roles,json=spectatorsSeeRoles,proto3" json:"spectators_see_roles,omitempty"`
+	KillTieBreak          KillTieBreak      `protobuf:"varint,13,opt,name=kill_tie_break,json=killTieBreak,proto3,enum=jinrou.KillTieBreak" json:"kill_tie_break,omitempty"`
+	RunoffVoters          RunoffVoters      `protobuf:"varint,14,opt,name=runoff_voters,json=runoffVoters,proto3,enum=jinrou.RunoffVoters" json:"runoff_voters,omitempty"`
+	ExecutionTieBreak     ExecutionTieBreak `protobuf:"varint,15,opt,name=execution_tie_break,json=executionTieBreak,proto3,enum=jinrou.ExecutionTieBreak" json:"execution_tie_break,omitempty"`
+	OpenBallots           bool              `protobuf:"varint,16,opt,name=open_ballots,json=openBallots,proto3" json:"open_ballots,omitempty"`
+	Scapegoat             bool              `protobuf:"varint,17,opt,name=scapegoat,proto3" json:"scapegoat,omitempty"`
+	ScapegoatVillagerOnly bool              `protobuf:"varint,18,opt,name=scapegoat_villager_only,json=scapegoatVillagerOnly,proto3" json:"scapegoat_villager_only,omitempty"`
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Config) GetPlayerNum() int32 {
+	if x != nil {
+		return x.PlayerNum
+	}
+	return 0
+}
+
+func (x *Config) GetWerewolfNum() int32 {
+	if x != nil {
+		return x.WerewolfNum
+	}
+	return 0
+}
+
+func (x *Config) GetAllowConsecutiveGuard() bool {
+	if x != nil {
+		return x.AllowConsecutiveGuard
+	}
+	return false
+}
+
+func (x *Config) GetRoleNums() []*RoleNum {
+	if x != nil {
+		return x.RoleNums
+	}
+	return nil
+}
+
+func (x *Config) GetDiscussionSeconds() int32 {
+	if x != nil {
+		return x.DiscussionSeconds
+	}
+	return 0
+}
+
+func (x *Config) GetVotingSeconds() int32 {
+	if x != nil {
+		return x.VotingSeconds
+	}
+	return 0
+}
+
+func (x *Config) GetNightSeconds() int32 {
+	if x != nil {
+		return x.NightSeconds
+	}
+	return 0
+}
+
+func (x *Config) GetRandomKillOnTimeout() bool {
+	if x != nil {
+		return x.RandomKillOnTimeout
+	}
+	return false
+}
+
+func (x *Config) GetSpectatorsSeeRoles() bool {
+	if x != nil {
+		return x.SpectatorsSeeRoles
+	}
+	return false
+}
+
+func (x *Config) GetKillTieBreak() KillTieBreak {
+	if x != nil {
+		return x.KillTieBreak
+	}
+	return KillTieBreak_KILL_RANDOMLY
+}
+
+func (x *Config) GetRunoffVoters() RunoffVoters {
+	if x != nil {
+		return x.RunoffVoters
+	}
+	return RunoffVoters_EVERYONE_VOTES
+}
+
+func (x *Config) GetExecutionTieBreak() ExecutionTieBreak {
+	if x != nil {
+		return x.ExecutionTieBreak
+	}
+	return ExecutionTieBreak_EXECUTE_NOBODY
+}
+
+func (x *Config) GetOpenBallots() bool {
+	if x != nil {
+		return x.OpenBallots
+	}
+	return false
+}
+
+func (x *Config) GetScapegoat() bool {
+	if x != nil {
+		return x.Scapegoat
+	}
+	return false
+}
+
+func (x *Config) GetScapegoatVillagerOnly() bool {
+	if x != nil {
+		return x.ScapegoatVillagerOnly
+	}
+	return false
+}
+
+type RoleNum struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role Role  `protobuf:"varint,1,opt,name=role,proto3,enum=jinrou.Role" json:"role,omitempty"`
+	Num  int32 `protobuf:"varint,2,opt,name=num,proto3" json:"num,omitempty"`
+}
+
+func (x *RoleNum) Reset() {
+	*x = RoleNum{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleNum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleNum) ProtoMessage() {}
+
+func (x *RoleNum) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleNum.ProtoReflect.Descriptor instead.
+func (*RoleNum) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RoleNum) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_UNKOWN
+}
+
+func (x *RoleNum) GetNum() int32 {
+	if x != nil {
+		return x.Num
+	}
+	return 0
+}
+
+type GameSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId    string  `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Config    *Config `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	PlayerNum int32   `protobuf:"varint,3,opt,name=player_num,json=playerNum,proto3" json:"player_num,omitempty"`
+	OwnerName string  `protobuf:"bytes,4,opt,name=owner_name,json=ownerName,proto3" json:"owner_name,omitempty"`
+}
+
+func (x *GameSummary) Reset() {
+	*x = GameSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameSummary) ProtoMessage() {}
+
+func (x *GameSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameSummary.ProtoReflect.Descriptor instead.
+func (*GameSummary) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GameSummary) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameSummary) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *GameSummary) GetPlayerNum() int32 {
+	if x != nil {
+		return x.PlayerNum
+	}
+	return 0
+}
+
+func (x *GameSummary) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+type Player struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId   uint64 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PlayerName string `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	IsDied     bool   `protobuf:"varint,3,opt,name=is_died,json=isDied,proto3" json:"is_died,omitempty"`
+	Index      int32  `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	IsDummy    bool   `protobuf:"varint,5,opt,name=is_dummy,json=isDummy,proto3" json:"is_dummy,omitempty"`
+}
+
+func (x *Player) Reset() {
+	*x = Player{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Player) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Player) ProtoMessage() {}
+
+func (x *Player) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Player.ProtoReflect.Descriptor instead.
+func (*Player) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Player) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *Player) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *Player) GetIsDied() bool {
+	if x != nil {
+		return x.IsDied
+	}
+	return false
+}
+
+func (x *Player) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Player) GetIsDummy() bool {
+	if x != nil {
+		return x.IsDummy
+	}
+	return false
+}
+
+type Message struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId uint64                 `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	PlayerId  uint64                 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Channel   Channel                `protobuf:"varint,3,opt,name=channel,proto3,enum=jinrou.Channel" json:"channel,omitempty"`
+	Text      string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	Phase     Phase                  `protobuf:"varint,5,opt,name=phase,proto3,enum=jinrou.Phase" json:"phase,omitempty"`
+	Day       int32                  `protobuf:"varint,6,opt,name=day,proto3" json:"day,omitempty"`
+	SentTime  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=sent_time,json=sentTime,proto3" json:"sent_time,omitempty"`
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *Message) GetMessageId() uint64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *Message) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *Message) GetChannel() Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return Channel_PUBLIC_CHANNEL
+}
+
+func (x *Message) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Message) GetPhase() Phase {
+	if x != nil {
+		return x.Phase
+	}
+	return Phase_START
+}
+
+func (x *Message) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *Message) GetSentTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentTime
+	}
+	return nil
+}
+
+type PrivateEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId         uint64            `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Type            PrivateEventType  `protobuf:"varint,2,opt,name=type,proto3,enum=jinrou.PrivateEventType" json:"type,omitempty"`
+	Phase           Phase             `protobuf:"varint,3,opt,name=phase,proto3,enum=jinrou.Phase" json:"phase,omitempty"`
+	Day             int32             `protobuf:"varint,4,opt,name=day,proto3" json:"day,omitempty"`
+	Roles           map[uint64]Role   `protobuf:"bytes,5,rep,name=roles,proto3" json:"roles,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=jinrou.Role"`
+	Votes           map[uint64]uint64 `protobuf:"bytes,6,rep,name=votes,proto3" json:"votes,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	LockedPlayerIds []uint64          `protobuf:"varint,7,rep,packed,name=locked_player_ids,json=lockedPlayerIds,proto3" json:"locked_player_ids,omitempty"`
+}
+
+func (x *PrivateEvent) Reset() {
+	*x = PrivateEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jinrou_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrivateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivateEvent) ProtoMessage() {}
+
+func (x *PrivateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_jinrou_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivateEvent.ProtoReflect.Descriptor instead.
+func (*PrivateEvent) Descriptor() ([]byte, []int) {
+	return file_jinrou_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *PrivateEvent) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *PrivateEvent) GetType() PrivateEventType {
+	if x != nil {
+		return x.Type
+	}
+	return PrivateEventType_ROLE_ASSIGNED
+}
+
+func (x *PrivateEvent) GetPhase() Phase {
+	if x != nil {
+		return x.Phase
+	}
+	return Phase_START
+}
+
+func (x *PrivateEvent) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *PrivateEvent) GetRoles() map[uint64]Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *PrivateEvent) GetVotes() map[uint64]uint64 {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+func (x *PrivateEvent) GetLockedPlayerIds() []uint64 {
+	if x != nil {
+		return x.LockedPlayerIds
+	}
+	return nil
+}
+
+var File_jinrou_proto protoreflect.FileDescriptor
+
+var file_jinrou_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06,
+	0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x32, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x6a, 0x0a, 0x10, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x35, 0x0a, 0x0e, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4c,
+	0x0a, 0x0f, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x55, 0x0a, 0x11,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x22, 0x39, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f,
+	0x75, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x8b,
+	0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x6f, 0x6e, 0x6c, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x6e, 0x4f, 0x6e, 0x6c, 0x79,
+	0x12, 0x2c, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x52, 0x6f, 0x6c,
+	0x65, 0x4e, 0x75, 0x6d, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x75, 0x6d, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x54, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x29, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x22, 0x15, 0x0a, 0x13, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4c, 0x6f, 0x62,
+	0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x76, 0x0a, 0x14, 0x4f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x35, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x4c,
+	0x6f, 0x62, 0x62, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x04, 0x67, 0x61, 0x6d,
+	0x65, 0x22, 0x17, 0x0a, 0x15, 0x55, 0x6e, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4c, 0x6f,
+	0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x18, 0x0a, 0x16, 0x55, 0x6e,
+	0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x0a, 0x0b, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x0c,
+	0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6a, 0x69,
+	0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x22, 0x27, 0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x34, 0x0a, 0x0d, 0x4c, 0x65,
+	0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6a, 0x69, 0x6e,
+	0x72, 0x6f, 0x75, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x22, 0x2a, 0x0a, 0x0f, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x10,
+	0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x23, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x30, 0x0a, 0x15, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x70, 0x65,
+	0x63, 0x74, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x74, 0x6f, 0x70, 0x53,
+	0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x43, 0x0a, 0x0b, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x0e, 0x0a, 0x0c, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x0a, 0x0b, 0x4b, 0x69, 0x6c, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x0e, 0x0a, 0x0c, 0x4b,
+	0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x0a, 0x0d, 0x4c,
+	0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x10, 0x0a, 0x0e, 0x4c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x0a, 0x12, 0x52, 0x65, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x52, 0x65, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x6b, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x4d, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x76, 0x6f, 0x74, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x22, 0x45, 0x0a, 0x0d, 0x44, 0x69, 0x76, 0x69, 0x6e,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x32,
+	0x0a, 0x0e, 0x44, 0x69, 0x76, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x20, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c,
+	0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69,
+	0x64, 0x65, 0x22, 0x44, 0x0a, 0x0c, 0x47, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x0f, 0x0a, 0x0d, 0x47, 0x75, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x69, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x53, 0x69, 0x64,
+	0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x22, 0x44, 0x0a, 0x0c, 0x53, 0x68, 0x6f, 0x6f, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x0f, 0x0a,
+	0x0d, 0x53, 0x68, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x78,
+	0x0a, 0x0b, 0x42, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x66, 0x69, 0x72, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28,
+	0x0a, 0x10, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x0e, 0x0a, 0x0c, 0x42, 0x69, 0x6e, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x0a, 0x0b, 0x4e, 0x65, 0x78, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64,
+	0x22, 0x0e, 0x0a, 0x0c, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x29, 0x0a, 0x0e, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x46,
+	0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x95, 0x01, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x39, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x1a, 0x46, 0x0a, 0x0a, 0x52, 0x6f,
+	0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x6a, 0x69, 0x6e, 0x72,
+	0x6f, 0x75, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x2a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x3a,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6c, 0x6f, 0x76,
+	0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x5c, 0x0a, 0x13, 0x4f, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e,
+	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xbd, 0x04, 0x0a, 0x14, 0x4f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x23, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6a, 0x69,
+	0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x6f,
+	0x6c, 0x64, 0x5f, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d,
+	0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x08, 0x6f,
+	0x6c, 0x64, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x64, 0x64, 0x65, 0x64,
+	0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x48, 0x00, 0x52, 0x0d, 0x61, 0x64, 0x64, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0c, 0x6c, 0x65, 0x66,
+	0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x6b, 0x69, 0x6c,
+	0x6c, 0x65, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0e, 0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x53,
+	0x69, 0x64, 0x65, 0x48, 0x00, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x30, 0x0a,
+	0x13, 0x66, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x11, 0x66, 0x6f,
+	0x72, 0x66, 0x65, 0x69, 0x74, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x25, 0x0a, 0x06, 0x64, 0x65, 0x61, 0x74, 0x68, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x44, 0x65, 0x61, 0x74, 0x68, 0x52, 0x06,
+	0x64, 0x65, 0x61, 0x74, 0x68, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x3d, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x4f, 0x62, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x56, 0x6f, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65,
+	0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x22, 0x4e, 0x0a, 0x05, 0x44, 0x65, 0x61, 0x74,
+	0x68, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28,
+	0x0a, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
+	0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x44, 0x65, 0x61, 0x74, 0x68, 0x43, 0x61, 0x75, 0x73,
+	0x65, 0x52, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x22, 0x30, 0x0a, 0x15, 0x55, 0x6e, 0x6f, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x55, 0x6e,
+	0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61,
+	0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d,
+	0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x6e, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31,
+	0x0a, 0x16, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49,
+	0x64, 0x22, 0x44, 0x0a, 0x17, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x33, 0x0a, 0x18, 0x55, 0x6e, 0x6f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x1b, 0x0a, 0x19,
+	0x55, 0x6e, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x0a, 0x1b, 0x4f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49,
+	0x64, 0x22, 0x4a, 0x0a, 0x1c, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x50, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x38, 0x0a,
+	0x1d, 0x55, 0x6e, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x20, 0x0a, 0x1e, 0x55, 0x6e, 0x6f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x86, 0x03, 0x0a, 0x05, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6a,
+	0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x23, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x50, 0x68, 0x61,
+	0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x34, 0x0a, 0x07, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a,
+	0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x75, 0x6e, 0x6f, 0x66, 0x66, 0x5f, 0x63,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x04, 0x52, 0x12, 0x72, 0x75, 0x6e, 0x6f, 0x66, 0x66, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x49, 0x64, 0x73, 0x1a, 0x4a, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75,
+	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xdf, 0x05, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x12, 0x21, 0x0a, 0x0c,
+	0x77, 0x65, 0x72, 0x65, 0x77, 0x6f, 0x6c, 0x66, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x77, 0x65, 0x72, 0x65, 0x77, 0x6f, 0x6c, 0x66, 0x4e, 0x75, 0x6d, 0x12,
+	0x36, 0x0a, 0x17, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x67, 0x75, 0x61, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x15, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x76, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x12, 0x2c, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x5f,
+	0x6e, 0x75, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6a, 0x69, 0x6e,
+	0x72, 0x6f, 0x75, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x4e, 0x75, 0x6d, 0x52, 0x08, 0x72, 0x6f, 0x6c,
+	0x65, 0x4e, 0x75, 0x6d, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x11, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x76, 0x6f,
+	0x74, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6e,
+	0x69, 0x67, 0x68, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x33, 0x0a, 0x16, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x5f, 0x6b, 0x69, 0x6c, 0x6c, 0x5f,
+	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x13, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x4b, 0x69, 0x6c, 0x6c, 0x4f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x5f, 0x73, 0x65, 0x65, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x12, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x53,
+	0x65, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0e, 0x6b, 0x69, 0x6c, 0x6c, 0x5f,
+	0x74, 0x69, 0x65, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x14, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x54, 0x69, 0x65,
+	0x42, 0x72, 0x65, 0x61, 0x6b, 0x52, 0x0c, 0x6b, 0x69, 0x6c, 0x6c, 0x54, 0x69, 0x65, 0x42, 0x72,
+	0x65, 0x61, 0x6b, 0x12, 0x39, 0x0a, 0x0d, 0x72, 0x75, 0x6e, 0x6f, 0x66, 0x66, 0x5f, 0x76, 0x6f,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6a, 0x69, 0x6e,
+	0x72, 0x6f, 0x75, 0x2e, 0x52, 0x75, 0x6e, 0x6f, 0x66, 0x66, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x0c, 0x72, 0x75, 0x6e, 0x6f, 0x66, 0x66, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x12, 0x49,
+	0x0a, 0x13, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x65, 0x5f,
+	0x62, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6a, 0x69,
+	0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x52, 0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x69, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65,
+	0x6e, 0x5f, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x6f, 0x70, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x63, 0x61, 0x70, 0x65, 0x67, 0x6f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x73, 0x63, 0x61, 0x70, 0x65, 0x67, 0x6f, 0x61, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x73, 0x63,
+	0x61, 0x70, 0x65, 0x67, 0x6f, 0x61, 0x74, 0x5f, 0x76, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x72,
+	0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x73, 0x63, 0x61,
+	0x70, 0x65, 0x67, 0x6f, 0x61, 0x74, 0x56, 0x69, 0x6c, 0x6c, 0x61, 0x67, 0x65, 0x72, 0x4f, 0x6e,
+	0x6c, 0x79, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04,
+	0x08, 0x06, 0x10, 0x07, 0x22, 0x3d, 0x0a, 0x07, 0x52, 0x6f, 0x6c, 0x65, 0x4e, 0x75, 0x6d, 0x12,
+	0x20, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e,
+	0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x6e, 0x75, 0x6d, 0x22, 0x8c, 0x01, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6a,
+	0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e,
+	0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x4e, 0x75, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69,
+	0x73, 0x5f, 0x64, 0x69, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73,
+	0x44, 0x69, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73,
+	0x5f, 0x64, 0x75, 0x6d, 0x6d, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73,
+	0x44, 0x75, 0x6d, 0x6d, 0x79, 0x22, 0xf4, 0x01, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x29, 0x0a,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f,
+	0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x23, 0x0a, 0x05,
+	0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6a, 0x69,
+	0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x64, 0x61, 0x79, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xaa, 0x03, 0x0a,
+	0x0c, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e,
+	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x50,
+	0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64,
+	0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x35, 0x0a,
+	0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6a,
+	0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x72,
+	0x6f, 0x6c, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x50, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0f, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x73, 0x1a, 0x46, 0x0a, 0x0a, 0x52, 0x6f, 0x6c, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x38, 0x0a, 0x0a, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x2a, 0x3d, 0x0a, 0x05, 0x50, 0x68, 0x61,
+	0x73, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x4e, 0x4f, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x49, 0x47, 0x48, 0x54,
+	0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x45, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x48,
+	0x55, 0x4e, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x2a, 0x49, 0x0a, 0x04, 0x53, 0x69, 0x64, 0x65,
+	0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x45, 0x55, 0x54, 0x52, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0d, 0x0a,
+	0x09, 0x56, 0x49, 0x4c, 0x4c, 0x41, 0x47, 0x45, 0x52, 0x53, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a,
+	0x57, 0x45, 0x52, 0x45, 0x57, 0x4f, 0x4c, 0x56, 0x45, 0x53, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05,
+	0x46, 0x4f, 0x58, 0x45, 0x53, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x4f, 0x56, 0x45, 0x52,
+	0x53, 0x10, 0x04, 0x2a, 0x7f, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x55,
+	0x4e, 0x4b, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x56, 0x49, 0x4c, 0x4c, 0x41,
+	0x47, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x57, 0x45, 0x52, 0x45, 0x57, 0x4f, 0x4c,
+	0x46, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x45, 0x45, 0x52, 0x10, 0x03, 0x12, 0x0d, 0x0a,
+	0x09, 0x42, 0x4f, 0x44, 0x59, 0x47, 0x55, 0x41, 0x52, 0x44, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06,
+	0x4d, 0x45, 0x44, 0x49, 0x55, 0x4d, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x44, 0x4d,
+	0x41, 0x4e, 0x10, 0x06, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x4f, 0x58, 0x10, 0x07, 0x12, 0x0a, 0x0a,
+	0x06, 0x48, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x10, 0x08, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x55, 0x50,
+	0x49, 0x44, 0x10, 0x09, 0x2a, 0xb0, 0x01, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x4a, 0x4f,
+	0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52,
+	0x5f, 0x4c, 0x45, 0x46, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x48, 0x41, 0x53, 0x45,
+	0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x48,
+	0x41, 0x53, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x4f, 0x55, 0x54, 0x5f, 0x4b, 0x49, 0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x0d, 0x0a,
+	0x09, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b,
+	0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x53, 0x48, 0x4f, 0x54, 0x10, 0x05, 0x12, 0x14, 0x0a,
+	0x10, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x46, 0x4f, 0x52, 0x46, 0x45, 0x49, 0x54, 0x45,
+	0x44, 0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x55, 0x4e, 0x4f, 0x46, 0x46, 0x5f, 0x53, 0x54,
+	0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x07, 0x2a, 0x5a, 0x0a, 0x0a, 0x44, 0x65, 0x61, 0x74, 0x68,
+	0x43, 0x61, 0x75, 0x73, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x54, 0x54, 0x41, 0x43, 0x4b, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x55, 0x52, 0x53, 0x45, 0x44, 0x10, 0x02, 0x12, 0x08, 0x0a,
+	0x04, 0x53, 0x48, 0x4f, 0x54, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x49, 0x43, 0x49,
+	0x44, 0x45, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x46, 0x45, 0x49, 0x54, 0x45,
+	0x44, 0x10, 0x05, 0x2a, 0x4a, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x12,
+	0x0a, 0x0e, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c,
+	0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x57, 0x45, 0x52, 0x45, 0x57, 0x4f, 0x4c, 0x46, 0x5f, 0x43,
+	0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x52, 0x41, 0x56,
+	0x45, 0x59, 0x41, 0x52, 0x44, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x02, 0x2a,
+	0x3d, 0x0a, 0x10, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x53, 0x53, 0x49,
+	0x47, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x4b, 0x49, 0x4c, 0x4c, 0x5f, 0x56,
+	0x4f, 0x54, 0x45, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x01, 0x2a, 0x49,
+	0x0a, 0x0c, 0x4b, 0x69, 0x6c, 0x6c, 0x54, 0x69, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x11,
+	0x0a, 0x0d, 0x4b, 0x49, 0x4c, 0x4c, 0x5f, 0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x4c, 0x59, 0x10,
+	0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4b, 0x49, 0x4c, 0x4c, 0x5f, 0x4e, 0x4f, 0x42, 0x4f, 0x44, 0x59,
+	0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4b, 0x49, 0x4c, 0x4c, 0x5f, 0x42, 0x59, 0x5f, 0x4c, 0x45,
+	0x41, 0x44, 0x5f, 0x57, 0x4f, 0x4c, 0x46, 0x10, 0x02, 0x2a, 0x3b, 0x0a, 0x0c, 0x52, 0x75, 0x6e,
+	0x6f, 0x66, 0x66, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x56, 0x45,
+	0x52, 0x59, 0x4f, 0x4e, 0x45, 0x5f, 0x56, 0x4f, 0x54, 0x45, 0x53, 0x10, 0x00, 0x12, 0x17, 0x0a,
+	0x13, 0x4e, 0x4f, 0x4e, 0x5f, 0x43, 0x41, 0x4e, 0x44, 0x49, 0x44, 0x41, 0x54, 0x45, 0x53, 0x5f,
+	0x56, 0x4f, 0x54, 0x45, 0x10, 0x01, 0x2a, 0x3d, 0x0a, 0x11, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x12, 0x0a, 0x0e, 0x45,
+	0x58, 0x45, 0x43, 0x55, 0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x42, 0x4f, 0x44, 0x59, 0x10, 0x00, 0x12,
+	0x14, 0x0a, 0x10, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x44, 0x4f,
+	0x4d, 0x4c, 0x59, 0x10, 0x01, 0x2a, 0x72, 0x0a, 0x0e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x47, 0x41, 0x4d, 0x45, 0x5f,
+	0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x41, 0x4d,
+	0x45, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52,
+	0x5f, 0x4c, 0x45, 0x46, 0x54, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x47, 0x41, 0x4d, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x47, 0x41, 0x4d,
+	0x45, 0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x04, 0x32, 0xc7, 0x10, 0x0a, 0x06, 0x4a, 0x69,
+	0x6e, 0x72, 0x6f, 0x75, 0x12, 0x3d, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x12, 0x17, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6a, 0x69, 0x6e, 0x72,
+	0x6f, 0x75, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x16,
+	0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x43, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x2e,
+	0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f,
+	0x75, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65,
+	0x73, 0x12, 0x18, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47,
+	0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6a, 0x69,
+	0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x1b, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e,
+	0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x4f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x30, 0x01, 0x12, 0x4f, 0x0a, 0x0e, 0x55, 0x6e, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x1d, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x55,
+	0x6e, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x55, 0x6e,
+	0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x13, 0x2e, 0x6a,
+	0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x76, 0x65,
+	0x12, 0x14, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e,
+	0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a,
+	0x08, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x12, 0x17, 0x2e, 0x6a, 0x69, 0x6e, 0x72,
+	0x6f, 0x75, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x53, 0x70, 0x65, 0x63,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0e,
+	0x53, 0x74, 0x6f, 0x70, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1d,
+	0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x70, 0x65, 0x63,
+	0x74, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x70, 0x65, 0x63, 0x74,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a,
+	0x04, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x13, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x56,
+	0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6a, 0x69, 0x6e,
+	0x72, 0x6f, 0x75, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x31, 0x0a, 0x04, 0x4b, 0x69, 0x6c, 0x6c, 0x12, 0x13, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f,
+	0x75, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x4c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x12, 0x15, 0x2e,
+	0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x4c, 0x6f,
+	0x63, 0x6b, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b,
+	0x52, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x6a, 0x69,
+	0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x56, 0x6f, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75,
+	0x2e, 0x52, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x56, 0x6f, 0x74,
+	0x65, 0x12, 0x18, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x79,
+	0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6a, 0x69,
+	0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x44, 0x69, 0x76, 0x69, 0x6e, 0x65,
+	0x12, 0x15, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x44, 0x69, 0x76, 0x69, 0x6e, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75,
+	0x2e, 0x44, 0x69, 0x76, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x34, 0x0a, 0x05, 0x47, 0x75, 0x61, 0x72, 0x64, 0x12, 0x14, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f,
+	0x75, 0x2e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69,
+	0x75, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f,
+	0x75, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f,
+	0x75, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x53, 0x68, 0x6f,
+	0x6f, 0x74, 0x12, 0x14, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x53, 0x68, 0x6f, 0x6f,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f,
+	0x75, 0x2e, 0x53, 0x68, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x31, 0x0a, 0x04, 0x42, 0x69, 0x6e, 0x64, 0x12, 0x13, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75,
+	0x2e, 0x42, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6a,
+	0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x42, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x13, 0x2e, 0x6a, 0x69, 0x6e,
+	0x72, 0x6f, 0x75, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74,
+	0x12, 0x16, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f,
+	0x75, 0x2e, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3d, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x17, 0x2e,
+	0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3d, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x6a,
+	0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x47,
+	0x65, 0x74, 0x4c, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4b, 0x0a, 0x0c, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x1b, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6a,
+	0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x4f, 0x0a, 0x0e,
+	0x55, 0x6e, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d,
+	0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x55, 0x6e, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x55, 0x6e, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a,
+	0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x2e, 0x6a,
+	0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f,
+	0x75, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f,
+	0x75, 0x2e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f,
+	0x75, 0x2e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x58, 0x0a, 0x11, 0x55,
+	0x6e, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x20, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x55, 0x6e, 0x6f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x55, 0x6e, 0x6f, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x14, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x2e,
+	0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x50, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x4f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x67, 0x0a, 0x16, 0x55, 0x6e,
+	0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x6a, 0x69, 0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x55, 0x6e,
+	0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6a, 0x69,
+	0x6e, 0x72, 0x6f, 0x75, 0x2e, 0x55, 0x6e, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x50, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_jinrou_proto_rawDescOnce sync.Once
+	file_jinrou_proto_rawDescData = file_jinrou_proto_rawDesc
+)
+
+func file_jinrou_proto_rawDescGZIP() []byte {
+	file_jinrou_proto_rawDescOnce.Do(func() {
+		file_jinrou_proto_rawDescData = protoimpl.X.CompressGZIP(file_jinrou_proto_rawDescData)
+	})
+	return file_jinrou_proto_rawDescData
+}
+
+var file_jinrou_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_jinrou_proto_msgTypes = make([]protoimpl.MessageInfo, 75)
+var file_jinrou_proto_goTypes = []interface{}{
+	(Phase)(0),                             // 0: jinrou.Phase
+	(Side)(0),                              // 1: jinrou.Side
+	(Role)(0),                              // 2: jinrou.Role
+	(ChangeType)(0),                        // 3: jinrou.ChangeType
+	(DeathCause)(0),                        // 4: jinrou.DeathCause
+	(Channel)(0),                           // 5: jinrou.Channel
+	(PrivateEventType)(0),                  // 6: jinrou.PrivateEventType
+	(KillTieBreak)(0),                      // 7: jinrou.KillTieBreak
+	(RunoffVoters)(0),                      // 8: jinrou.RunoffVoters
+	(ExecutionTieBreak)(0),                 // 9: jinrou.ExecutionTieBreak
+	(LobbyEventType)(0),                    // 10: jinrou.LobbyEventType
+	(*RegisterRequest)(nil),                // 11: jinrou.RegisterRequest
+	(*RegisterResponse)(nil),               // 12: jinrou.RegisterResponse
+	(*RefreshRequest)(nil),                 // 13: jinrou.RefreshRequest
+	(*RefreshResponse)(nil),                // 14: jinrou.RefreshResponse
+	(*CreateGameRequest)(nil),              // 15: jinrou.CreateGameRequest
+	(*CreateGameResponse)(nil),             // 16: jinrou.CreateGameResponse
+	(*ListGamesRequest)(nil),               // 17: jinrou.ListGamesRequest
+	(*ListGamesResponse)(nil),              // 18: jinrou.ListGamesResponse
+	(*ObserveLobbyRequest)(nil),            // 19: jinrou.ObserveLobbyRequest
+	(*ObserveLobbyResponse)(nil),           // 20: jinrou.ObserveLobbyResponse
+	(*UnobserveLobbyRequest)(nil),          // 21: jinrou.UnobserveLobbyRequest
+	(*UnobserveLobbyResponse)(nil),         // 22: jinrou.UnobserveLobbyResponse
+	(*JoinRequest)(nil),                    // 23: jinrou.JoinRequest
+	(*JoinResponse)(nil),                   // 24: jinrou.JoinResponse
+	(*LeaveRequest)(nil),                   // 25: jinrou.LeaveRequest
+	(*LeaveResponse)(nil),                  // 26: jinrou.LeaveResponse
+	(*SpectateRequest)(nil),                // 27: jinrou.SpectateRequest
+	(*SpectateResponse)(nil),               // 28: jinrou.SpectateResponse
+	(*StopSpectatingRequest)(nil),          // 29: jinrou.StopSpectatingRequest
+	(*StopSpectatingResponse)(nil),         // 30: jinrou.StopSpectatingResponse
+	(*VoteRequest)(nil),                    // 31: jinrou.VoteRequest
+	(*VoteResponse)(nil),                   // 32: jinrou.VoteResponse
+	(*KillRequest)(nil),                    // 33: jinrou.KillRequest
+	(*KillResponse)(nil),                   // 34: jinrou.KillResponse
+	(*LockInRequest)(nil),                  // 35: jinrou.LockInRequest
+	(*LockInResponse)(nil),                 // 36: jinrou.LockInResponse
+	(*RetractVoteRequest)(nil),             // 37: jinrou.RetractVoteRequest
+	(*RetractVoteResponse)(nil),            // 38: jinrou.RetractVoteResponse
+	(*GetMyVoteRequest)(nil),               // 39: jinrou.GetMyVoteRequest
+	(*GetMyVoteResponse)(nil),              // 40: jinrou.GetMyVoteResponse
+	(*DivineRequest)(nil),                  // 41: jinrou.DivineRequest
+	(*DivineResponse)(nil),                 // 42: jinrou.DivineResponse
+	(*GuardRequest)(nil),                   // 43: jinrou.GuardRequest
+	(*GuardResponse)(nil),                  // 44: jinrou.GuardResponse
+	(*GetMediumResultRequest)(nil),         // 45: jinrou.GetMediumResultRequest
+	(*GetMediumResultResponse)(nil),        // 46: jinrou.GetMediumResultResponse
+	(*ShootRequest)(nil),                   // 47: jinrou.ShootRequest
+	(*ShootResponse)(nil),                  // 48: jinrou.ShootResponse
+	(*BindRequest)(nil),                    // 49: jinrou.BindRequest
+	(*BindResponse)(nil),                   // 50: jinrou.BindResponse
+	(*NextRequest)(nil),                    // 51: jinrou.NextRequest
+	(*NextResponse)(nil),                   // 52: jinrou.NextResponse
+	(*ForfeitRequest)(nil),                 // 53: jinrou.ForfeitRequest
+	(*ForfeitResponse)(nil),                // 54: jinrou.ForfeitResponse
+	(*GetRolesRequest)(nil),                // 55: jinrou.GetRolesRequest
+	(*GetRolesResponse)(nil),               // 56: jinrou.GetRolesResponse
+	(*GetLoverRequest)(nil),                // 57: jinrou.GetLoverRequest
+	(*GetLoverResponse)(nil),               // 58: jinrou.GetLoverResponse
+	(*ObserveStateRequest)(nil),            // 59: jinrou.ObserveStateRequest
+	(*ObserveStateResponse)(nil),           // 60: jinrou.ObserveStateResponse
+	(*Death)(nil),                          // 61: jinrou.Death
+	(*UnobserveStateRequest)(nil),          // 62: jinrou.UnobserveStateRequest
+	(*UnobserveStateResponse)(nil),         // 63: jinrou.UnobserveStateResponse
+	(*SendMessageRequest)(nil),             // 64: jinrou.SendMessageRequest
+	(*SendMessageResponse)(nil),            // 65: jinrou.SendMessageResponse
+	(*ObserveMessagesRequest)(nil),         // 66: jinrou.ObserveMessagesRequest
+	(*ObserveMessagesResponse)(nil),        // 67: jinrou.ObserveMessagesResponse
+	(*UnobserveMessagesRequest)(nil),       // 68: jinrou.UnobserveMessagesRequest
+	(*UnobserveMessagesResponse)(nil),      // 69: jinrou.UnobserveMessagesResponse
+	(*ObservePrivateEventsRequest)(nil),    // 70: jinrou.ObservePrivateEventsRequest
+	(*ObservePrivateEventsResponse)(nil),   // 71: jinrou.ObservePrivateEventsResponse
+	(*UnobservePrivateEventsRequest)(nil),  // 72: jinrou.UnobservePrivateEventsRequest
+	(*UnobservePrivateEventsResponse)(nil), // 73: jinrou.UnobservePrivateEventsResponse
+	(*State)(nil),                          // 74: jinrou.State
+	(*Config)(nil),                         // 75: jinrou.Config
+	(*RoleNum)(nil),                        // 76: jinrou.RoleNum
+	(*GameSummary)(nil),                    // 77: jinrou.GameSummary
+	(*Player)(nil),                         // 78: jinrou.Player
+	(*Message)(nil),                        // 79: jinrou.Message
+	(*PrivateEvent)(nil),                   // 80: jinrou.PrivateEvent
+	nil,                                    // 81: jinrou.GetRolesResponse.RolesEntry
+	nil,                                    // 82: jinrou.ObserveStateResponse.VotesEntry
+	nil,                                    // 83: jinrou.State.PlayersEntry
+	nil,                                    // 84: jinrou.PrivateEvent.RolesEntry
+	nil,                                    // 85: jinrou.PrivateEvent.VotesEntry
+	(*timestamppb.Timestamp)(nil),          // 86: google.protobuf.Timestamp
+}
+var file_jinrou_proto_depIdxs = []int32{
+	75, // 0: jinrou.CreateGameRequest.config:type_name -> jinrou.Config
+	74, // 1: jinrou.CreateGameResponse.state:type_name -> jinrou.State
+	76, // 2: jinrou.ListGamesRequest.role_nums:type_name -> jinrou.RoleNum
+	77, // 3: jinrou.ListGamesResponse.games:type_name -> jinrou.GameSummary
+	10, // 4: jinrou.ObserveLobbyResponse.event_type:type_name -> jinrou.LobbyEventType
+	77, // 5: jinrou.ObserveLobbyResponse.game:type_name -> jinrou.GameSummary
+	74, // 6: jinrou.JoinResponse.state:type_name -> jinrou.State
+	74, // 7: jinrou.LeaveResponse.state:type_name -> jinrou.State
+	74, // 8: jinrou.SpectateResponse.state:type_name -> jinrou.State
+	1,  // 9: jinrou.DivineResponse.side:type_name -> jinrou.Side
+	1,  // 10: jinrou.GetMediumResultResponse.side:type_name -> jinrou.Side
+	81, // 11: jinrou.GetRolesResponse.roles:type_name -> jinrou.GetRolesResponse.RolesEntry
+	74, // 12: jinrou.ObserveStateResponse.state:type_name -> jinrou.State
+	3,  // 13: jinrou.ObserveStateResponse.change_type:type_name -> jinrou.ChangeType
+	0,  // 14: jinrou.ObserveStateResponse.old_phase:type_name -> jinrou.Phase
+	1,  // 15: jinrou.ObserveStateResponse.winner:type_name -> jinrou.Side
+	61, // 16: jinrou.ObserveStateResponse.deaths:type_name -> jinrou.Death
+	82, // 17: jinrou.ObserveStateResponse.votes:type_name -> jinrou.ObserveStateResponse.VotesEntry
+	4,  // 18: jinrou.Death.cause:type_name -> jinrou.DeathCause
+	79, // 19: jinrou.ObserveMessagesResponse.message:type_name -> jinrou.Message
+	80, // 20: jinrou.ObservePrivateEventsResponse.event:type_name -> jinrou.PrivateEvent
+	75, // 21: jinrou.State.config:type_name -> jinrou.Config
+	0,  // 22: jinrou.State.phase:type_name -> jinrou.Phase
+	83, // 23: jinrou.State.players:type_name -> jinrou.State.PlayersEntry
+	86, // 24: jinrou.State.deadline:type_name -> google.protobuf.Timestamp
+	76, // 25: jinrou.Config.role_nums:type_name -> jinrou.RoleNum
+	7,  // 26: jinrou.Config.kill_tie_break:type_name -> jinrou.KillTieBreak
+	8,  // 27: jinrou.Config.runoff_voters:type_name -> jinrou.RunoffVoters
+	9,  // 28: jinrou.Config.execution_tie_break:type_name -> jinrou.ExecutionTieBreak
+	2,  // 29: jinrou.RoleNum.role:type_name -> jinrou.Role
+	75, // 30: jinrou.GameSummary.config:type_name -> jinrou.Config
+	5,  // 31: jinrou.Message.channel:type_name -> jinrou.Channel
+	0,  // 32: jinrou.Message.phase:type_name -> jinrou.Phase
+	86, // 33: jinrou.Message.sent_time:type_name -> google.protobuf.Timestamp
+	6,  // 34: jinrou.PrivateEvent.type:type_name -> jinrou.PrivateEventType
+	0,  // 35: jinrou.PrivateEvent.phase:type_name -> jinrou.Phase
+	84, // 36: jinrou.PrivateEvent.roles:type_name -> jinrou.PrivateEvent.RolesEntry
+	85, // 37: jinrou.PrivateEvent.votes:type_name -> jinrou.PrivateEvent.VotesEntry
+	2,  // 38: jinrou.GetRolesResponse.RolesEntry.value:type_name -> jinrou.Role
+	78, // 39: jinrou.State.PlayersEntry.value:type_name -> jinrou.Player
+	2,  // 40: jinrou.PrivateEvent.RolesEntry.value:type_name -> jinrou.Role
+	11, // 41: jinrou.Jinrou.Register:input_type -> jinrou.RegisterRequest
+	13, // 42: jinrou.Jinrou.Refresh:input_type -> jinrou.RefreshRequest
+	15, // 43: jinrou.Jinrou.CreateGame:input_type -> jinrou.CreateGameRequest
+	17, // 44: jinrou.Jinrou.ListGames:input_type -> jinrou.ListGamesRequest
+	19, // 45: jinrou.Jinrou.ObserveLobby:input_type -> jinrou.ObserveLobbyRequest
+	21, // 46: jinrou.Jinrou.UnobserveLobby:input_type -> jinrou.UnobserveLobbyRequest
+	23, // 47: jinrou.Jinrou.Join:input_type -> jinrou.JoinRequest
+	25, // 48: jinrou.Jinrou.Leave:input_type -> jinrou.LeaveRequest
+	27, // 49: jinrou.Jinrou.Spectate:input_type -> jinrou.SpectateRequest
+	29, // 50: jinrou.Jinrou.StopSpectating:input_type -> jinrou.StopSpectatingRequest
+	31, // 51: jinrou.Jinrou.Vote:input_type -> jinrou.VoteRequest
+	33, // 52: jinrou.Jinrou.Kill:input_type -> jinrou.KillRequest
+	35, // 53: jinrou.Jinrou.LockIn:input_type -> jinrou.LockInRequest
+	37, // 54: jinrou.Jinrou.RetractVote:input_type -> jinrou.RetractVoteRequest
+	39, // 55: jinrou.Jinrou.GetMyVote:input_type -> jinrou.GetMyVoteRequest
+	41, // 56: jinrou.Jinrou.Divine:input_type -> jinrou.DivineRequest
+	43, // 57: jinrou.Jinrou.Guard:input_type -> jinrou.GuardRequest
+	45, // 58: jinrou.Jinrou.GetMediumResult:input_type -> jinrou.GetMediumResultRequest
+	47, // 59: jinrou.Jinrou.Shoot:input_type -> jinrou.ShootRequest
+	49, // 60: jinrou.Jinrou.Bind:input_type -> jinrou.BindRequest
+	51, // 61: jinrou.Jinrou.Next:input_type -> jinrou.NextRequest
+	53, // 62: jinrou.Jinrou.Forfeit:input_type -> jinrou.ForfeitRequest
+	55, // 63: jinrou.Jinrou.GetRoles:input_type -> jinrou.GetRolesRequest
+	57, // 64: jinrou.Jinrou.GetLover:input_type -> jinrou.GetLoverRequest
+	59, // 65: jinrou.Jinrou.ObserveState:input_type -> jinrou.ObserveStateRequest
+	62, // 66: jinrou.Jinrou.UnobserveState:input_type -> jinrou.UnobserveStateRequest
+	64, // 67: jinrou.Jinrou.SendMessage:input_type -> jinrou.SendMessageRequest
+	66, // 68: jinrou.Jinrou.ObserveMessages:input_type -> jinrou.ObserveMessagesRequest
+	68, // 69: jinrou.Jinrou.UnobserveMessages:input_type -> jinrou.UnobserveMessagesRequest
+	70, // 70: jinrou.Jinrou.ObservePrivateEvents:input_type -> jinrou.ObservePrivateEventsRequest
+	72, // 71: jinrou.Jinrou.UnobservePrivateEvents:input_type -> jinrou.UnobservePrivateEventsRequest
+	12, // 72: jinrou.Jinrou.Register:output_type -> jinrou.RegisterResponse
+	14, // 73: jinrou.Jinrou.Refresh:output_type -> jinrou.RefreshResponse
+	16, // 74: jinrou.Jinrou.CreateGame:output_type -> jinrou.CreateGameResponse
+	18, // 75: jinrou.Jinrou.ListGames:output_type -> jinrou.ListGamesResponse
+	20, // 76: jinrou.Jinrou.ObserveLobby:output_type -> jinrou.ObserveLobbyResponse
+	22, // 77: jinrou.Jinrou.UnobserveLobby:output_type -> jinrou.UnobserveLobbyResponse
+	24, // 78: jinrou.Jinrou.Join:output_type -> jinrou.JoinResponse
+	26, // 79: jinrou.Jinrou.Leave:output_type -> jinrou.LeaveResponse
+	28, // 80: jinrou.Jinrou.Spectate:output_type -> jinrou.SpectateResponse
+	30, // 81: jinrou.Jinrou.StopSpectating:output_type -> jinrou.StopSpectatingResponse
+	32, // 82: jinrou.Jinrou.Vote:output_type -> jinrou.VoteResponse
+	34, // 83: jinrou.Jinrou.Kill:output_type -> jinrou.KillResponse
+	36, // 84: jinrou.Jinrou.LockIn:output_type -> jinrou.LockInResponse
+	38, // 85: jinrou.Jinrou.RetractVote:output_type -> jinrou.RetractVoteResponse
+	40, // 86: jinrou.Jinrou.GetMyVote:output_type -> jinrou.GetMyVoteResponse
+	42, // 87: jinrou.Jinrou.Divine:output_type -> jinrou.DivineResponse
+	44, // 88: jinrou.Jinrou.Guard:output_type -> jinrou.GuardResponse
+	46, // 89: jinrou.Jinrou.GetMediumResult:output_type -> jinrou.GetMediumResultResponse
+	48, // 90: jinrou.Jinrou.Shoot:output_type -> jinrou.ShootResponse
+	50, // 91: jinrou.Jinrou.Bind:output_type -> jinrou.BindResponse
+	52, // 92: jinrou.Jinrou.Next:output_type -> jinrou.NextResponse
+	54, // 93: jinrou.Jinrou.Forfeit:output_type -> jinrou.ForfeitResponse
+	56, // 94: jinrou.Jinrou.GetRoles:output_type -> jinrou.GetRolesResponse
+	58, // 95: jinrou.Jinrou.GetLover:output_type -> jinrou.GetLoverResponse
+	60, // 96: jinrou.Jinrou.ObserveState:output_type -> jinrou.ObserveStateResponse
+	63, // 97: jinrou.Jinrou.UnobserveState:output_type -> jinrou.UnobserveStateResponse
+	65, // 98: jinrou.Jinrou.SendMessage:output_type -> jinrou.SendMessageResponse
+	67, // 99: jinrou.Jinrou.ObserveMessages:output_type -> jinrou.ObserveMessagesResponse
+	69, // 100: jinrou.Jinrou.UnobserveMessages:output_type -> jinrou.UnobserveMessagesResponse
+	71, // 101: jinrou.Jinrou.ObservePrivateEvents:output_type -> jinrou.ObservePrivateEventsResponse
+	73, // 102: jinrou.Jinrou.UnobservePrivateEvents:output_type -> jinrou.UnobservePrivateEventsResponse
+	72, // [72:103] is the sub-list for method output_type
+	41, // [41:72] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
+}
+
+func init() { file_jinrou_proto_init() }
+func file_jinrou_proto_init() {
+	if File_jinrou_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_jinrou_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGamesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGamesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObserveLobbyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObserveLobbyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnobserveLobbyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnobserveLobbyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpectateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpectateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopSpectatingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopSpectatingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KillRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KillResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockInRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1851,8 +5490,8 @@ func file_jinrou_proto_init() {
 				return nil
 			}
 		}
-		file_jinrou_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterResponse); i {
+		file_jinrou_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockInResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1863,8 +5502,8 @@ func file_jinrou_proto_init() {
 				return nil
 			}
 		}
-		file_jinrou_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefreshRequest); i {
+		file_jinrou_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetractVoteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1875,8 +5514,8 @@ func file_jinrou_proto_init() {
 				return nil
 			}
 		}
-		file_jinrou_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefreshResponse); i {
+		file_jinrou_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetractVoteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1887,8 +5526,8 @@ func file_jinrou_proto_init() {
 				return nil
 			}
 		}
-		file_jinrou_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateGameRequest); i {
+		file_jinrou_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMyVoteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1899,8 +5538,8 @@ func file_jinrou_proto_init() {
 				return nil
 			}
 		}
-		file_jinrou_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateGameResponse); i {
+		file_jinrou_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMyVoteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1911,8 +5550,8 @@ func file_jinrou_proto_init() {
 				return nil
 			}
 		}
-		file_jinrou_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinRequest); i {
+		file_jinrou_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DivineRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1923,8 +5562,8 @@ func file_jinrou_proto_init() {
 				return nil
 			}
 		}
-		file_jinrou_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinResponse); i {
+		file_jinrou_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DivineResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1935,8 +5574,8 @@ func file_jinrou_proto_init() {
 				return nil
 			}
 		}
-		file_jinrou_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaveRequest); i {
+		file_jinrou_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1947,8 +5586,8 @@ func file_jinrou_proto_init() {
 				return nil
 			}
 		}
-		file_jinrou_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaveResponse); i {
+		file_jinrou_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuardResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1959,8 +5598,8 @@ func file_jinrou_proto_init() {
 				return nil
 			}
 		}
-		file_jinrou_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VoteRequest); i {
+		file_jinrou_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMediumResultRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1971,8 +5610,8 @@ func file_jinrou_proto_init() {
 				return nil
 			}
 		}
-		file_jinrou_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VoteResponse); i {
+		file_jinrou_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMediumResultResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1983,8 +5622,8 @@ func file_jinrou_proto_init() {
 				return nil
 			}
 		}
-		file_jinrou_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KillRequest); i {
+		file_jinrou_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShootRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1995,8 +5634,8 @@ func file_jinrou_proto_init() {
 				return nil
 			}
 		}
-		file_jinrou_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KillResponse); i {
+		file_jinrou_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShootResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2007,7 +5646,31 @@ func file_jinrou_proto_init() {
 				return nil
 			}
 		}
-		file_jinrou_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_jinrou_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BindRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BindResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NextRequest); i {
 			case 0:
 				return &v.state
@@ -2019,7 +5682,7 @@ func file_jinrou_proto_init() {
 				return nil
 			}
 		}
-		file_jinrou_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_jinrou_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NextResponse); i {
 			case 0:
 				return &v.state
@@ -2031,7 +5694,31 @@ func file_jinrou_proto_init() {
 				return nil
 			}
 		}
-		file_jinrou_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_jinrou_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForfeitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForfeitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetRolesRequest); i {
 			case 0:
 				return &v.state
@@ -2043,7 +5730,7 @@ func file_jinrou_proto_init() {
 				return nil
 			}
 		}
-		file_jinrou_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_jinrou_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetRolesResponse); i {
 			case 0:
 				return &v.state
@@ -2055,7 +5742,31 @@ func file_jinrou_proto_init() {
 				return nil
 			}
 		}
-		file_jinrou_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_jinrou_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLoverRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLoverResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ObserveStateRequest); i {
 			case 0:
 				return &v.state
@@ -2067,7 +5778,7 @@ func file_jinrou_proto_init() {
 				return nil
 			}
 		}
-		file_jinrou_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_jinrou_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ObserveStateResponse); i {
 			case 0:
 				return &v.state
@@ -2079,7 +5790,19 @@ func file_jinrou_proto_init() {
 				return nil
 			}
 		}
-		file_jinrou_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_jinrou_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Death); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnobserveStateRequest); i {
 			case 0:
 				return &v.state
@@ -2091,7 +5814,7 @@ func file_jinrou_proto_init() {
 				return nil
 			}
 		}
-		file_jinrou_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_jinrou_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnobserveStateResponse); i {
 			case 0:
 				return &v.state
@@ -2103,7 +5826,127 @@ func file_jinrou_proto_init() {
 				return nil
 			}
 		}
-		file_jinrou_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_jinrou_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendMessageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendMessageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObserveMessagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObserveMessagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnobserveMessagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnobserveMessagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObservePrivateEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObservePrivateEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnobservePrivateEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnobservePrivateEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*State); i {
 			case 0:
 				return &v.state
@@ -2115,7 +5958,7 @@ func file_jinrou_proto_init() {
 				return nil
 			}
 		}
-		file_jinrou_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_jinrou_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Config); i {
 			case 0:
 				return &v.state
@@ -2127,7 +5970,31 @@ func file_jinrou_proto_init() {
 				return nil
 			}
 		}
-		file_jinrou_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_jinrou_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleNum); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Player); i {
 			case 0:
 				return &v.state
@@ -2139,20 +6006,45 @@ func file_jinrou_proto_init() {
 				return nil
 			}
 		}
+		file_jinrou_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Message); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jinrou_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrivateEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_jinrou_proto_msgTypes[19].OneofWrappers = []interface{}{
+	file_jinrou_proto_msgTypes[49].OneofWrappers = []interface{}{
 		(*ObserveStateResponse_AddedPlayerId)(nil),
 		(*ObserveStateResponse_LeftPlayerId)(nil),
 		(*ObserveStateResponse_KilledPlayerId)(nil),
 		(*ObserveStateResponse_Winner)(nil),
+		(*ObserveStateResponse_ForfeitedPlayerId)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_jinrou_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   27,
+			NumEnums:      11,
+			NumMessages:   75,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,14 +20,34 @@ type JinrouClient interface {
 	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
 	Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*RefreshResponse, error)
 	CreateGame(ctx context.Context, in *CreateGameRequest, opts ...grpc.CallOption) (*CreateGameResponse, error)
+	ListGames(ctx context.Context, in *ListGamesRequest, opts ...grpc.CallOption) (*ListGamesResponse, error)
+	ObserveLobby(ctx context.Context, in *ObserveLobbyRequest, opts ...grpc.CallOption) (Jinrou_ObserveLobbyClient, error)
+	UnobserveLobby(ctx context.Context, in *UnobserveLobbyRequest, opts ...grpc.CallOption) (*UnobserveLobbyResponse, error)
 	Join(ctx context.Context, in *JoinRequest, opts ...grpc.CallOption) (*JoinResponse, error)
 	Leave(ctx context.Context, in *LeaveRequest, opts ...grpc.CallOption) (*LeaveResponse, error)
+	Spectate(ctx context.Context, in *SpectateRequest, opts ...grpc.CallOption) (*SpectateResponse, error)
+	StopSpectating(ctx context.Context, in *StopSpectatingRequest, opts ...grpc.CallOption) (*StopSpectatingResponse, error)
 	Vote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*VoteResponse, error)
 	Kill(ctx context.Context, in *KillRequest, opts ...grpc.CallOption) (*KillResponse, error)
+	LockIn(ctx context.Context, in *LockInRequest, opts ...grpc.CallOption) (*LockInResponse, error)
+	RetractVote(ctx context.Context, in *RetractVoteRequest, opts ...grpc.CallOption) (*RetractVoteResponse, error)
+	GetMyVote(ctx context.Context, in *GetMyVoteRequest, opts ...grpc.CallOption) (*GetMyVoteResponse, error)
+	Divine(ctx context.Context, in *DivineRequest, opts ...grpc.CallOption) (*DivineResponse, error)
+	Guard(ctx context.Context, in *GuardRequest, opts ...grpc.CallOption) (*GuardResponse, error)
+	GetMediumResult(ctx context.Context, in *GetMediumResultRequest, opts ...grpc.CallOption) (*GetMediumResultResponse, error)
+	Shoot(ctx context.Context, in *ShootRequest, opts ...grpc.CallOption) (*ShootResponse, error)
+	Bind(ctx context.Context, in *BindRequest, opts ...grpc.CallOption) (*BindResponse, error)
 	Next(ctx context.Context, in *NextRequest, opts ...grpc.CallOption) (*NextResponse, error)
+	Forfeit(ctx context.Context, in *ForfeitRequest, opts ...grpc.CallOption) (*ForfeitResponse, error)
 	GetRoles(ctx context.Context, in *GetRolesRequest, opts ...grpc.CallOption) (*GetRolesResponse, error)
+	GetLover(ctx context.Context, in *GetLoverRequest, opts ...grpc.CallOption) (*GetLoverResponse, error)
 	ObserveState(ctx context.Context, in *ObserveStateRequest, opts ...grpc.CallOption) (Jinrou_ObserveStateClient, error)
 	UnobserveState(ctx context.Context, in *UnobserveStateRequest, opts ...grpc.CallOption) (*UnobserveStateResponse, error)
+	SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*SendMessageResponse, error)
+	ObserveMessages(ctx context.Context, in *ObserveMessagesRequest, opts ...grpc.CallOption) (Jinrou_ObserveMessagesClient, error)
+	UnobserveMessages(ctx context.Context, in *UnobserveMessagesRequest, opts ...grpc.CallOption) (*UnobserveMessagesResponse, error)
+	ObservePrivateEvents(ctx context.Context, in *ObservePrivateEventsRequest, opts ...grpc.CallOption) (Jinrou_ObservePrivateEventsClient, error)
+	UnobservePrivateEvents(ctx context.Context, in *UnobservePrivateEventsRequest, opts ...grpc.CallOption) (*UnobservePrivateEventsResponse, error)
 }
 
 type jinrouClient struct {
@@ -65,6 +85,56 @@ func (c *jinrouClient) CreateGame(ctx context.Context, in *CreateGameRequest, op
 	return out, nil
 }
 
+func (c *jinrouClient) ListGames(ctx context.Context, in *ListGamesRequest, opts ...grpc.CallOption) (*ListGamesResponse, error) {
+	out := new(ListGamesResponse)
+	err := c.cc.Invoke(ctx, "/jinrou.Jinrou/ListGames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jinrouClient) ObserveLobby(ctx context.Context, in *ObserveLobbyRequest, opts ...grpc.CallOption) (Jinrou_ObserveLobbyClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Jinrou_serviceDesc.Streams[0], "/jinrou.Jinrou/ObserveLobby", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jinrouObserveLobbyClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Jinrou_ObserveLobbyClient interface {
+	Recv() (*ObserveLobbyResponse, error)
+	grpc.ClientStream
+}
+
+type jinrouObserveLobbyClient struct {
+	grpc.ClientStream
+}
+
+func (x *jinrouObserveLobbyClient) Recv() (*ObserveLobbyResponse, error) {
+	m := new(ObserveLobbyResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jinrouClient) UnobserveLobby(ctx context.Context, in *UnobserveLobbyRequest, opts ...grpc.CallOption) (*UnobserveLobbyResponse, error) {
+	out := new(UnobserveLobbyResponse)
+	err := c.cc.Invoke(ctx, "/jinrou.Jinrou/UnobserveLobby", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jinrouClient) Join(ctx context.Context, in *JoinRequest, opts ...grpc.CallOption) (*JoinResponse, error) {
 	out := new(JoinResponse)
 	err := c.cc.Invoke(ctx, "/jinrou.Jinrou/Join", in, out, opts...)
@@ -83,6 +153,24 @@ func (c *jinrouClient) Leave(ctx context.Context, in *LeaveRequest, opts ...grpc
 	return out, nil
 }
 
+func (c *jinrouClient) Spectate(ctx context.Context, in *SpectateRequest, opts ...grpc.CallOption) (*SpectateResponse, error) {
+	out := new(SpectateResponse)
+	err := c.cc.Invoke(ctx, "/jinrou.Jinrou/Spectate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jinrouClient) StopSpectating(ctx context.Context, in *StopSpectatingRequest, opts ...grpc.CallOption) (*StopSpectatingResponse, error) {
+	out := new(StopSpectatingResponse)
+	err := c.cc.Invoke(ctx, "/jinrou.Jinrou/StopSpectating", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jinrouClient) Vote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*VoteResponse, error) {
 	out := new(VoteResponse)
 	err := c.cc.Invoke(ctx, "/jinrou.Jinrou/Vote", in, out, opts...)
@@ -101,6 +189,78 @@ func (c *jinrouClient) Kill(ctx context.Context, in *KillRequest, opts ...grpc.C
 	return out, nil
 }
 
+func (c *jinrouClient) LockIn(ctx context.Context, in *LockInRequest, opts ...grpc.CallOption) (*LockInResponse, error) {
+	out := new(LockInResponse)
+	err := c.cc.Invoke(ctx, "/jinrou.Jinrou/LockIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jinrouClient) RetractVote(ctx context.Context, in *RetractVoteRequest, opts ...grpc.CallOption) (*RetractVoteResponse, error) {
+	out := new(RetractVoteResponse)
+	err := c.cc.Invoke(ctx, "/jinrou.Jinrou/RetractVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jinrouClient) GetMyVote(ctx context.Context, in *GetMyVoteRequest, opts ...grpc.CallOption) (*GetMyVoteResponse, error) {
+	out := new(GetMyVoteResponse)
+	err := c.cc.Invoke(ctx, "/jinrou.Jinrou/GetMyVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jinrouClient) Divine(ctx context.Context, in *DivineRequest, opts ...grpc.CallOption) (*DivineResponse, error) {
+	out := new(DivineResponse)
+	err := c.cc.Invoke(ctx, "/jinrou.Jinrou/Divine", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jinrouClient) Guard(ctx context.Context, in *GuardRequest, opts ...grpc.CallOption) (*GuardResponse, error) {
+	out := new(GuardResponse)
+	err := c.cc.Invoke(ctx, "/jinrou.Jinrou/Guard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jinrouClient) GetMediumResult(ctx context.Context, in *GetMediumResultRequest, opts ...grpc.CallOption) (*GetMediumResultResponse, error) {
+	out := new(GetMediumResultResponse)
+	err := c.cc.Invoke(ctx, "/jinrou.Jinrou/GetMediumResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jinrouClient) Shoot(ctx context.Context, in *ShootRequest, opts ...grpc.CallOption) (*ShootResponse, error) {
+	out := new(ShootResponse)
+	err := c.cc.Invoke(ctx, "/jinrou.Jinrou/Shoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jinrouClient) Bind(ctx context.Context, in *BindRequest, opts ...grpc.CallOption) (*BindResponse, error) {
+	out := new(BindResponse)
+	err := c.cc.Invoke(ctx, "/jinrou.Jinrou/Bind", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jinrouClient) Next(ctx context.Context, in *NextRequest, opts ...grpc.CallOption) (*NextResponse, error) {
 	out := new(NextResponse)
 	err := c.cc.Invoke(ctx, "/jinrou.Jinrou/Next", in, out, opts...)
@@ -110,6 +270,15 @@ func (c *jinrouClient) Next(ctx context.Context, in *NextRequest, opts ...grpc.C
 	return out, nil
 }
 
+func (c *jinrouClient) Forfeit(ctx context.Context, in *ForfeitRequest, opts ...grpc.CallOption) (*ForfeitResponse, error) {
+	out := new(ForfeitResponse)
+	err := c.cc.Invoke(ctx, "/jinrou.Jinrou/Forfeit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jinrouClient) GetRoles(ctx context.Context, in *GetRolesRequest, opts ...grpc.CallOption) (*GetRolesResponse, error) {
 	out := new(GetRolesResponse)
 	err := c.cc.Invoke(ctx, "/jinrou.Jinrou/GetRoles", in, out, opts...)
@@ -119,8 +288,17 @@ func (c *jinrouClient) GetRoles(ctx context.Context, in *GetRolesRequest, opts .
 	return out, nil
 }
 
+func (c *jinrouClient) GetLover(ctx context.Context, in *GetLoverRequest, opts ...grpc.CallOption) (*GetLoverResponse, error) {
+	out := new(GetLoverResponse)
+	err := c.cc.Invoke(ctx, "/jinrou.Jinrou/GetLover", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jinrouClient) ObserveState(ctx context.Context, in *ObserveStateRequest, opts ...grpc.CallOption) (Jinrou_ObserveStateClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Jinrou_serviceDesc.Streams[0], "/jinrou.Jinrou/ObserveState", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Jinrou_serviceDesc.Streams[1], "/jinrou.Jinrou/ObserveState", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -160,6 +338,97 @@ func (c *jinrouClient) UnobserveState(ctx context.Context, in *UnobserveStateReq
 	return out, nil
 }
 
+func (c *jinrouClient) SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*SendMessageResponse, error) {
+	out := new(SendMessageResponse)
+	err := c.cc.Invoke(ctx, "/jinrou.Jinrou/SendMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jinrouClient) ObserveMessages(ctx context.Context, in *ObserveMessagesRequest, opts ...grpc.CallOption) (Jinrou_ObserveMessagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Jinrou_serviceDesc.Streams[2], "/jinrou.Jinrou/ObserveMessages", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jinrouObserveMessagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Jinrou_ObserveMessagesClient interface {
+	Recv() (*ObserveMessagesResponse, error)
+	grpc.ClientStream
+}
+
+type jinrouObserveMessagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *jinrouObserveMessagesClient) Recv() (*ObserveMessagesResponse, error) {
+	m := new(ObserveMessagesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jinrouClient) UnobserveMessages(ctx context.Context, in *UnobserveMessagesRequest, opts ...grpc.CallOption) (*UnobserveMessagesResponse, error) {
+	out := new(UnobserveMessagesResponse)
+	err := c.cc.Invoke(ctx, "/jinrou.Jinrou/UnobserveMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jinrouClient) ObservePrivateEvents(ctx context.Context, in *ObservePrivateEventsRequest, opts ...grpc.CallOption) (Jinrou_ObservePrivateEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Jinrou_serviceDesc.Streams[3], "/jinrou.Jinrou/ObservePrivateEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jinrouObservePrivateEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Jinrou_ObservePrivateEventsClient interface {
+	Recv() (*ObservePrivateEventsResponse, error)
+	grpc.ClientStream
+}
+
+type jinrouObservePrivateEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *jinrouObservePrivateEventsClient) Recv() (*ObservePrivateEventsResponse, error) {
+	m := new(ObservePrivateEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jinrouClient) UnobservePrivateEvents(ctx context.Context, in *UnobservePrivateEventsRequest, opts ...grpc.CallOption) (*UnobservePrivateEventsResponse, error) {
+	out := new(UnobservePrivateEventsResponse)
+	err := c.cc.Invoke(ctx, "/jinrou.Jinrou/UnobservePrivateEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JinrouServer is the server API for Jinrou service.
 // All implementations must embed UnimplementedJinrouServer
 // for forward compatibility
@@ -167,14 +436,34 @@ type JinrouServer interface {
 	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
 	Refresh(context.Context, *RefreshRequest) (*RefreshResponse, error)
 	CreateGame(context.Context, *CreateGameRequest) (*CreateGameResponse, error)
+	ListGames(context.Context, *ListGamesRequest) (*ListGamesResponse, error)
+	ObserveLobby(*ObserveLobbyRequest, Jinrou_ObserveLobbyServer) error
+	UnobserveLobby(context.Context, *UnobserveLobbyRequest) (*UnobserveLobbyResponse, error)
 	Join(context.Context, *JoinRequest) (*JoinResponse, error)
 	Leave(context.Context, *LeaveRequest) (*LeaveResponse, error)
+	Spectate(context.Context, *SpectateRequest) (*SpectateResponse, error)
+	StopSpectating(context.Context, *StopSpectatingRequest) (*StopSpectatingResponse, error)
 	Vote(context.Context, *VoteRequest) (*VoteResponse, error)
 	Kill(context.Context, *KillRequest) (*KillResponse, error)
+	LockIn(context.Context, *LockInRequest) (*LockInResponse, error)
+	RetractVote(context.Context, *RetractVoteRequest) (*RetractVoteResponse, error)
+	GetMyVote(context.Context, *GetMyVoteRequest) (*GetMyVoteResponse, error)
+	Divine(context.Context, *DivineRequest) (*DivineResponse, error)
+	Guard(context.Context, *GuardRequest) (*GuardResponse, error)
+	GetMediumResult(context.Context, *GetMediumResultRequest) (*GetMediumResultResponse, error)
+	Shoot(context.Context, *ShootRequest) (*ShootResponse, error)
+	Bind(context.Context, *BindRequest) (*BindResponse, error)
 	Next(context.Context, *NextRequest) (*NextResponse, error)
+	Forfeit(context.Context, *ForfeitRequest) (*ForfeitResponse, error)
 	GetRoles(context.Context, *GetRolesRequest) (*GetRolesResponse, error)
+	GetLover(context.Context, *GetLoverRequest) (*GetLoverResponse, error)
 	ObserveState(*ObserveStateRequest, Jinrou_ObserveStateServer) error
 	UnobserveState(context.Context, *UnobserveStateRequest) (*UnobserveStateResponse, error)
+	SendMessage(context.Context, *SendMessageRequest) (*SendMessageResponse, error)
+	ObserveMessages(*ObserveMessagesRequest, Jinrou_ObserveMessagesServer) error
+	UnobserveMessages(context.Context, *UnobserveMessagesRequest) (*UnobserveMessagesResponse, error)
+	ObservePrivateEvents(*ObservePrivateEventsRequest, Jinrou_ObservePrivateEventsServer) error
+	UnobservePrivateEvents(context.Context, *UnobservePrivateEventsRequest) (*UnobservePrivateEventsResponse, error)
 	mustEmbedUnimplementedJinrouServer()
 }
 
@@ -191,30 +480,90 @@ func (UnimplementedJinrouServer) Refresh(context.Context, *RefreshRequest) (*Ref
 func (UnimplementedJinrouServer) CreateGame(context.Context, *CreateGameRequest) (*CreateGameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateGame not implemented")
 }
+func (UnimplementedJinrouServer) ListGames(context.Context, *ListGamesRequest) (*ListGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGames not implemented")
+}
+func (UnimplementedJinrouServer) ObserveLobby(*ObserveLobbyRequest, Jinrou_ObserveLobbyServer) error {
+	return status.Errorf(codes.Unimplemented, "method ObserveLobby not implemented")
+}
+func (UnimplementedJinrouServer) UnobserveLobby(context.Context, *UnobserveLobbyRequest) (*UnobserveLobbyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnobserveLobby not implemented")
+}
 func (UnimplementedJinrouServer) Join(context.Context, *JoinRequest) (*JoinResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Join not implemented")
 }
 func (UnimplementedJinrouServer) Leave(context.Context, *LeaveRequest) (*LeaveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Leave not implemented")
 }
+func (UnimplementedJinrouServer) Spectate(context.Context, *SpectateRequest) (*SpectateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Spectate not implemented")
+}
+func (UnimplementedJinrouServer) StopSpectating(context.Context, *StopSpectatingRequest) (*StopSpectatingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopSpectating not implemented")
+}
 func (UnimplementedJinrouServer) Vote(context.Context, *VoteRequest) (*VoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
 }
 func (UnimplementedJinrouServer) Kill(context.Context, *KillRequest) (*KillResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Kill not implemented")
 }
+func (UnimplementedJinrouServer) LockIn(context.Context, *LockInRequest) (*LockInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockIn not implemented")
+}
+func (UnimplementedJinrouServer) RetractVote(context.Context, *RetractVoteRequest) (*RetractVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetractVote not implemented")
+}
+func (UnimplementedJinrouServer) GetMyVote(context.Context, *GetMyVoteRequest) (*GetMyVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyVote not implemented")
+}
+func (UnimplementedJinrouServer) Divine(context.Context, *DivineRequest) (*DivineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Divine not implemented")
+}
+func (UnimplementedJinrouServer) Guard(context.Context, *GuardRequest) (*GuardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Guard not implemented")
+}
+func (UnimplementedJinrouServer) GetMediumResult(context.Context, *GetMediumResultRequest) (*GetMediumResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMediumResult not implemented")
+}
+func (UnimplementedJinrouServer) Shoot(context.Context, *ShootRequest) (*ShootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Shoot not implemented")
+}
+func (UnimplementedJinrouServer) Bind(context.Context, *BindRequest) (*BindResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bind not implemented")
+}
 func (UnimplementedJinrouServer) Next(context.Context, *NextRequest) (*NextResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Next not implemented")
 }
+func (UnimplementedJinrouServer) Forfeit(context.Context, *ForfeitRequest) (*ForfeitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Forfeit not implemented")
+}
 func (UnimplementedJinrouServer) GetRoles(context.Context, *GetRolesRequest) (*GetRolesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRoles not implemented")
 }
+func (UnimplementedJinrouServer) GetLover(context.Context, *GetLoverRequest) (*GetLoverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLover not implemented")
+}
 func (UnimplementedJinrouServer) ObserveState(*ObserveStateRequest, Jinrou_ObserveStateServer) error {
 	return status.Errorf(codes.Unimplemented, "method ObserveState not implemented")
 }
 func (UnimplementedJinrouServer) UnobserveState(context.Context, *UnobserveStateRequest) (*UnobserveStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnobserveState not implemented")
 }
+func (UnimplementedJinrouServer) SendMessage(context.Context, *SendMessageRequest) (*SendMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
+}
+func (UnimplementedJinrouServer) ObserveMessages(*ObserveMessagesRequest, Jinrou_ObserveMessagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ObserveMessages not implemented")
+}
+func (UnimplementedJinrouServer) UnobserveMessages(context.Context, *UnobserveMessagesRequest) (*UnobserveMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnobserveMessages not implemented")
+}
+func (UnimplementedJinrouServer) ObservePrivateEvents(*ObservePrivateEventsRequest, Jinrou_ObservePrivateEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ObservePrivateEvents not implemented")
+}
+func (UnimplementedJinrouServer) UnobservePrivateEvents(context.Context, *UnobservePrivateEventsRequest) (*UnobservePrivateEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnobservePrivateEvents not implemented")
+}
 func (UnimplementedJinrouServer) mustEmbedUnimplementedJinrouServer() {}
 
 // UnsafeJinrouServer may be embedded to opt out of forward compatibility for this service.
@@ -282,6 +631,63 @@ func _Jinrou_CreateGame_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Jinrou_ListGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JinrouServer).ListGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jinrou.Jinrou/ListGames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JinrouServer).ListGames(ctx, req.(*ListGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Jinrou_ObserveLobby_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ObserveLobbyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JinrouServer).ObserveLobby(m, &jinrouObserveLobbyServer{stream})
+}
+
+type Jinrou_ObserveLobbyServer interface {
+	Send(*ObserveLobbyResponse) error
+	grpc.ServerStream
+}
+
+type jinrouObserveLobbyServer struct {
+	grpc.ServerStream
+}
+
+func (x *jinrouObserveLobbyServer) Send(m *ObserveLobbyResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Jinrou_UnobserveLobby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnobserveLobbyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JinrouServer).UnobserveLobby(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jinrou.Jinrou/UnobserveLobby",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JinrouServer).UnobserveLobby(ctx, req.(*UnobserveLobbyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Jinrou_Join_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(JoinRequest)
 	if err := dec(in); err != nil {
@@ -318,6 +724,42 @@ func _Jinrou_Leave_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Jinrou_Spectate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpectateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JinrouServer).Spectate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jinrou.Jinrou/Spectate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JinrouServer).Spectate(ctx, req.(*SpectateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Jinrou_StopSpectating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopSpectatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JinrouServer).StopSpectating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jinrou.Jinrou/StopSpectating",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JinrouServer).StopSpectating(ctx, req.(*StopSpectatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Jinrou_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VoteRequest)
 	if err := dec(in); err != nil {
@@ -354,6 +796,150 @@ func _Jinrou_Kill_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Jinrou_LockIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JinrouServer).LockIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jinrou.Jinrou/LockIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JinrouServer).LockIn(ctx, req.(*LockInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Jinrou_RetractVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetractVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JinrouServer).RetractVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jinrou.Jinrou/RetractVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JinrouServer).RetractVote(ctx, req.(*RetractVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Jinrou_GetMyVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JinrouServer).GetMyVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jinrou.Jinrou/GetMyVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JinrouServer).GetMyVote(ctx, req.(*GetMyVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Jinrou_Divine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DivineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JinrouServer).Divine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jinrou.Jinrou/Divine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JinrouServer).Divine(ctx, req.(*DivineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Jinrou_Guard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JinrouServer).Guard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jinrou.Jinrou/Guard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JinrouServer).Guard(ctx, req.(*GuardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Jinrou_GetMediumResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMediumResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JinrouServer).GetMediumResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jinrou.Jinrou/GetMediumResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JinrouServer).GetMediumResult(ctx, req.(*GetMediumResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Jinrou_Shoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JinrouServer).Shoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jinrou.Jinrou/Shoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JinrouServer).Shoot(ctx, req.(*ShootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Jinrou_Bind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BindRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JinrouServer).Bind(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jinrou.Jinrou/Bind",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JinrouServer).Bind(ctx, req.(*BindRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Jinrou_Next_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NextRequest)
 	if err := dec(in); err != nil {
@@ -372,6 +958,24 @@ func _Jinrou_Next_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Jinrou_Forfeit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForfeitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JinrouServer).Forfeit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jinrou.Jinrou/Forfeit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JinrouServer).Forfeit(ctx, req.(*ForfeitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Jinrou_GetRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetRolesRequest)
 	if err := dec(in); err != nil {
@@ -390,6 +994,24 @@ func _Jinrou_GetRoles_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Jinrou_GetLover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JinrouServer).GetLover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jinrou.Jinrou/GetLover",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JinrouServer).GetLover(ctx, req.(*GetLoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Jinrou_ObserveState_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ObserveStateRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -429,6 +1051,102 @@ func _Jinrou_UnobserveState_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Jinrou_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JinrouServer).SendMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jinrou.Jinrou/SendMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JinrouServer).SendMessage(ctx, req.(*SendMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Jinrou_ObserveMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ObserveMessagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JinrouServer).ObserveMessages(m, &jinrouObserveMessagesServer{stream})
+}
+
+type Jinrou_ObserveMessagesServer interface {
+	Send(*ObserveMessagesResponse) error
+	grpc.ServerStream
+}
+
+type jinrouObserveMessagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *jinrouObserveMessagesServer) Send(m *ObserveMessagesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Jinrou_UnobserveMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnobserveMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JinrouServer).UnobserveMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jinrou.Jinrou/UnobserveMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JinrouServer).UnobserveMessages(ctx, req.(*UnobserveMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Jinrou_ObservePrivateEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ObservePrivateEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JinrouServer).ObservePrivateEvents(m, &jinrouObservePrivateEventsServer{stream})
+}
+
+type Jinrou_ObservePrivateEventsServer interface {
+	Send(*ObservePrivateEventsResponse) error
+	grpc.ServerStream
+}
+
+type jinrouObservePrivateEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *jinrouObservePrivateEventsServer) Send(m *ObservePrivateEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Jinrou_UnobservePrivateEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnobservePrivateEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JinrouServer).UnobservePrivateEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jinrou.Jinrou/UnobservePrivateEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JinrouServer).UnobservePrivateEvents(ctx, req.(*UnobservePrivateEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Jinrou_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "jinrou.Jinrou",
 	HandlerType: (*JinrouServer)(nil),
@@ -445,6 +1163,14 @@ var _Jinrou_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateGame",
 			Handler:    _Jinrou_CreateGame_Handler,
 		},
+		{
+			MethodName: "ListGames",
+			Handler:    _Jinrou_ListGames_Handler,
+		},
+		{
+			MethodName: "UnobserveLobby",
+			Handler:    _Jinrou_UnobserveLobby_Handler,
+		},
 		{
 			MethodName: "Join",
 			Handler:    _Jinrou_Join_Handler,
@@ -453,6 +1179,14 @@ var _Jinrou_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Leave",
 			Handler:    _Jinrou_Leave_Handler,
 		},
+		{
+			MethodName: "Spectate",
+			Handler:    _Jinrou_Spectate_Handler,
+		},
+		{
+			MethodName: "StopSpectating",
+			Handler:    _Jinrou_StopSpectating_Handler,
+		},
 		{
 			MethodName: "Vote",
 			Handler:    _Jinrou_Vote_Handler,
@@ -461,25 +1195,92 @@ var _Jinrou_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Kill",
 			Handler:    _Jinrou_Kill_Handler,
 		},
+		{
+			MethodName: "LockIn",
+			Handler:    _Jinrou_LockIn_Handler,
+		},
+		{
+			MethodName: "RetractVote",
+			Handler:    _Jinrou_RetractVote_Handler,
+		},
+		{
+			MethodName: "GetMyVote",
+			Handler:    _Jinrou_GetMyVote_Handler,
+		},
+		{
+			MethodName: "Divine",
+			Handler:    _Jinrou_Divine_Handler,
+		},
+		{
+			MethodName: "Guard",
+			Handler:    _Jinrou_Guard_Handler,
+		},
+		{
+			MethodName: "GetMediumResult",
+			Handler:    _Jinrou_GetMediumResult_Handler,
+		},
+		{
+			MethodName: "Shoot",
+			Handler:    _Jinrou_Shoot_Handler,
+		},
+		{
+			MethodName: "Bind",
+			Handler:    _Jinrou_Bind_Handler,
+		},
 		{
 			MethodName: "Next",
 			Handler:    _Jinrou_Next_Handler,
 		},
+		{
+			MethodName: "Forfeit",
+			Handler:    _Jinrou_Forfeit_Handler,
+		},
 		{
 			MethodName: "GetRoles",
 			Handler:    _Jinrou_GetRoles_Handler,
 		},
+		{
+			MethodName: "GetLover",
+			Handler:    _Jinrou_GetLover_Handler,
+		},
 		{
 			MethodName: "UnobserveState",
 			Handler:    _Jinrou_UnobserveState_Handler,
 		},
+		{
+			MethodName: "SendMessage",
+			Handler:    _Jinrou_SendMessage_Handler,
+		},
+		{
+			MethodName: "UnobserveMessages",
+			Handler:    _Jinrou_UnobserveMessages_Handler,
+		},
+		{
+			MethodName: "UnobservePrivateEvents",
+			Handler:    _Jinrou_UnobservePrivateEvents_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ObserveLobby",
+			Handler:       _Jinrou_ObserveLobby_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ObserveState",
 			Handler:       _Jinrou_ObserveState_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ObserveMessages",
+			Handler:       _Jinrou_ObserveMessages_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ObservePrivateEvents",
+			Handler:       _Jinrou_ObservePrivateEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "jinrou.proto",
 }
@@ -1,51 +1,131 @@
 package usecase
 
 import (
+	"errors"
+	"log"
+	"sort"
+	"time"
+
 	"github.com/f-miyu/jinrou/server/app/domain"
 	"github.com/f-miyu/jinrou/server/app/domain/repository"
 )
 
+const endedGameRetention = 10 * time.Minute
+
 type GameUsecase interface {
-	CreateGame(playerID uint, config domain.Config) (state domain.State, err error)
+	CreateGame(playerID uint, config domain.Config, isPrivate bool) (state domain.State, err error)
+	ListGames(filter domain.GameFilter, offset int, limit int) (states []domain.State, total int, err error)
 	Join(gameID string, playerID uint) (state domain.State, err error)
 	Leave(gameID string, playerID uint) (state domain.State, err error)
+	Spectate(gameID string, userID uint) (state domain.State, err error)
+	StopSpectating(gameID string, userID uint) (err error)
 	Vote(gameID string, playerID uint, targetID uint) (err error)
 	Kill(gameID string, playerID uint, targetID uint) (err error)
+	LockIn(gameID string, playerID uint) (err error)
+	RetractVote(gameID string, playerID uint) (err error)
+	GetMyVote(gameID string, playerID uint) (targetID uint, voted bool, locked bool, err error)
+	Divine(gameID string, playerID uint, targetID uint) (side domain.Side, err error)
+	Guard(gameID string, playerID uint, targetID uint) (err error)
+	GetMediumResult(gameID string, playerID uint) (executedPlayerID uint, side domain.Side, err error)
+	Shoot(gameID string, playerID uint, targetID uint) (err error)
+	Bind(gameID string, playerID uint, firstID uint, secondID uint) (err error)
 	Next(gameID string, playerID uint) (err error)
+	Forfeit(gameID string, playerID uint) (err error)
 	GetRoles(gameID string, playerID uint) (roles map[uint]domain.Role, err error)
-	ObserveState(gameID string, playerID uint) (ch <-chan domain.StateChange, err error)
+	GetLoverID(gameID string, playerID uint) (loverID uint, err error)
+	ObserveState(gameID string, playerID uint, lastSeenSequence uint64) (backlog []domain.StateChange, observer *domain.StateObserver, err error)
 	UnobserveState(gameID string, playerID uint) (err error)
+	SendMessage(gameID string, playerID uint, text string) (err error)
+	ObserveMessages(gameID string, playerID uint) (backlog []domain.Message, observer *domain.MessageObserver, err error)
+	UnobserveMessages(gameID string, playerID uint) (err error)
+	ObservePrivateEvents(gameID string, playerID uint) (backlog []domain.PrivateEvent, observer *domain.PrivateEventObserver, err error)
+	UnobservePrivateEvents(gameID string, playerID uint) (err error)
+	ObserveLobby(userID uint) (ch <-chan domain.LobbyEvent)
+	UnobserveLobby(userID uint)
 }
 
 type gameUsecase struct {
 	gameRepository repository.GameRepository
 	userRepository repository.UserRepository
+	lobby          *domain.Lobby
 }
 
 func NewGameUsecase(gameRepository repository.GameRepository, userRepository repository.UserRepository) GameUsecase {
 	return &gameUsecase{
 		gameRepository: gameRepository,
 		userRepository: userRepository,
+		lobby:          domain.NewLobby(),
 	}
 }
 
-func (usecase *gameUsecase) CreateGame(playerID uint, config domain.Config) (state domain.State, err error) {
+func (usecase *gameUsecase) CreateGame(playerID uint, config domain.Config, isPrivate bool) (state domain.State, err error) {
 	user, err := usecase.userRepository.FindByID(playerID)
 	if err != nil {
 		return
 	}
 
-	game, err := domain.NewGame(config)
+	random, err := domain.NewRandom()
 	if err != nil {
 		return
 	}
 
+	game, err := domain.NewGame(config, random)
+	if err != nil {
+		return
+	}
+
+	// The seed is kept out of State so that players cannot predict the roles.
+	// Operators can replay a game by passing it to domain.NewSeededRandom.
+	log.Printf("game %s created with seed %d", game.ID, game.Seed)
+
+	game.IsPrivate = isPrivate
+
+	game.SetTimeoutHandler(func(state domain.State) {
+		usecase.storeOrDelete(game, state)
+	})
+
 	state, err = game.Join(playerID, user.Name)
 	if err != nil {
 		return
 	}
 
 	usecase.gameRepository.Store(game)
+	usecase.notifyLobby(game, domain.GameCreated, state)
+
+	return
+}
+
+func (usecase *gameUsecase) ListGames(filter domain.GameFilter, offset int, limit int) (states []domain.State, total int, err error) {
+	if offset < 0 || limit < 0 {
+		err = errors.New("invalid argument")
+		return
+	}
+
+	games := usecase.gameRepository.FindByPhase(domain.Start)
+
+	sort.Slice(games, func(i, j int) bool {
+		if games[i].CreatedTime.Equal(games[j].CreatedTime) {
+			return games[i].ID < games[j].ID
+		}
+		return games[i].CreatedTime.Before(games[j].CreatedTime)
+	})
+
+	states = []domain.State{}
+	for _, game := range games {
+		if game.IsPrivate {
+			continue
+		}
+
+		state := game.Snapshot()
+		if state.Phase != domain.Start || !filter.Match(state) {
+			continue
+		}
+
+		if total >= offset && (limit == 0 || len(states) < limit) {
+			states = append(states, state)
+		}
+		total++
+	}
 
 	return
 }
@@ -61,30 +141,18 @@ func (usecase *gameUsecase) Join(gameID string, playerID uint) (state domain.Sta
 		return
 	}
 
-	oldPhase := game.Phase
+	oldPhase := game.Snapshot().Phase
 
 	state, err = game.Join(playerID, user.Name)
 	if err != nil {
 		return
 	}
 
-	playerJoined := domain.StateChange{
-		State:         state,
-		ChangeType:    domain.PlayerJoined,
-		OldPhase:      oldPhase,
-		AddedPlayerID: playerID,
-	}
-
-	game.NotifyStateChanged(playerJoined)
+	usecase.notifyLobby(game, domain.GamePlayerJoined, state)
 
 	if state.Phase != oldPhase {
-		phaseChanged := domain.StateChange{
-			State:      state,
-			ChangeType: domain.PhaseChanged,
-			OldPhase:   oldPhase,
-		}
-
-		game.NotifyStateChanged(phaseChanged)
+		usecase.gameRepository.Store(game)
+		usecase.notifyLobby(game, domain.GameStarted, state)
 	}
 
 	return
@@ -96,41 +164,57 @@ func (usecase *gameUsecase) Leave(gameID string, playerID uint) (state domain.St
 		return
 	}
 
-	oldPhase := game.Phase
-
 	state, err = game.Leave(playerID)
 	if err != nil {
 		return
 	}
 
-	stateChanged := domain.StateChange{
-		State:        state,
-		ChangeType:   domain.PlayerLeft,
-		OldPhase:     oldPhase,
-		LeftPlayerID: playerID,
+	usecase.notifyLobby(game, domain.GamePlayerLeft, state)
+
+	if len(state.Players) == 0 {
+		game.Dispose()
+		usecase.gameRepository.Delete(game.ID)
+		usecase.notifyLobby(game, domain.GameEnded, state)
+	}
+
+	return
+}
+
+func (usecase *gameUsecase) Spectate(gameID string, userID uint) (state domain.State, err error) {
+	game, err := usecase.gameRepository.Load(gameID)
+	if err != nil {
+		return
 	}
 
-	game.NotifyStateChanged(stateChanged)
+	state, err = game.Spectate(userID)
 
 	return
 }
 
-func (usecase *gameUsecase) Vote(gameID string, playerID uint, targetID uint) (err error) {
+func (usecase *gameUsecase) StopSpectating(gameID string, userID uint) (err error) {
 	game, err := usecase.gameRepository.Load(gameID)
 	if err != nil {
 		return
 	}
 
-	oldPhase := game.Phase
+	err = game.StopSpectating(userID)
 
-	state, phaseResult, err := game.Vote(playerID, targetID)
+	return
+}
+
+func (usecase *gameUsecase) Vote(gameID string, playerID uint, targetID uint) (err error) {
+	game, err := usecase.gameRepository.Load(gameID)
+	if err != nil {
+		return
+	}
+
+	state, _, err := game.Vote(playerID, targetID)
 
 	if err != nil {
 		return
 	}
 
-	usecase.notifyStateChangedIfNeeded(game, state, phaseResult, oldPhase)
-	usecase.deleteIfNeeded(game, state)
+	usecase.storeOrDelete(game, state)
 
 	return
 }
@@ -141,16 +225,131 @@ func (usecase *gameUsecase) Kill(gameID string, playerID uint, targetID uint) (e
 		return
 	}
 
-	oldPhase := game.Phase
+	state, _, err := game.Kill(playerID, targetID)
+
+	if err != nil {
+		return
+	}
+
+	usecase.storeOrDelete(game, state)
+
+	return
+}
+
+func (usecase *gameUsecase) LockIn(gameID string, playerID uint) (err error) {
+	game, err := usecase.gameRepository.Load(gameID)
+	if err != nil {
+		return
+	}
+
+	state, _, err := game.LockIn(playerID)
+
+	if err != nil {
+		return
+	}
+
+	usecase.storeOrDelete(game, state)
+
+	return
+}
+
+func (usecase *gameUsecase) RetractVote(gameID string, playerID uint) (err error) {
+	game, err := usecase.gameRepository.Load(gameID)
+	if err != nil {
+		return
+	}
+
+	err = game.RetractVote(playerID)
+
+	return
+}
+
+func (usecase *gameUsecase) GetMyVote(gameID string, playerID uint) (targetID uint, voted bool, locked bool, err error) {
+	game, err := usecase.gameRepository.Load(gameID)
+	if err != nil {
+		return
+	}
+
+	targetID, voted, locked, err = game.GetMyVote(playerID)
+
+	return
+}
+
+func (usecase *gameUsecase) Divine(gameID string, playerID uint, targetID uint) (side domain.Side, err error) {
+	game, err := usecase.gameRepository.Load(gameID)
+	if err != nil {
+		return
+	}
+
+	state, _, side, err := game.Divine(playerID, targetID)
+
+	if err != nil {
+		return
+	}
+
+	usecase.storeOrDelete(game, state)
+
+	return
+}
+
+func (usecase *gameUsecase) Guard(gameID string, playerID uint, targetID uint) (err error) {
+	game, err := usecase.gameRepository.Load(gameID)
+	if err != nil {
+		return
+	}
+
+	state, _, err := game.Guard(playerID, targetID)
 
-	state, phaseResult, err := game.Kill(playerID, targetID)
+	if err != nil {
+		return
+	}
+
+	usecase.storeOrDelete(game, state)
+
+	return
+}
+
+func (usecase *gameUsecase) GetMediumResult(gameID string, playerID uint) (executedPlayerID uint, side domain.Side, err error) {
+	game, err := usecase.gameRepository.Load(gameID)
+	if err != nil {
+		return
+	}
+
+	executedPlayerID, side, err = game.GetMediumResult(playerID)
+
+	return
+}
+
+func (usecase *gameUsecase) Shoot(gameID string, playerID uint, targetID uint) (err error) {
+	game, err := usecase.gameRepository.Load(gameID)
+	if err != nil {
+		return
+	}
+
+	state, _, err := game.Shoot(playerID, targetID)
+
+	if err != nil {
+		return
+	}
+
+	usecase.storeOrDelete(game, state)
+
+	return
+}
+
+func (usecase *gameUsecase) Bind(gameID string, playerID uint, firstID uint, secondID uint) (err error) {
+	game, err := usecase.gameRepository.Load(gameID)
+	if err != nil {
+		return
+	}
+
+	state, _, err := game.Bind(playerID, firstID, secondID)
 
 	if err != nil {
 		return
 	}
 
-	usecase.notifyStateChangedIfNeeded(game, state, phaseResult, oldPhase)
-	usecase.deleteIfNeeded(game, state)
+	usecase.storeOrDelete(game, state)
 
 	return
 }
@@ -161,16 +360,30 @@ func (usecase *gameUsecase) Next(gameID string, playerID uint) (err error) {
 		return
 	}
 
-	oldPhase := game.Phase
+	state, _, err := game.Next(playerID)
+
+	if err != nil {
+		return
+	}
+
+	usecase.storeOrDelete(game, state)
+
+	return
+}
+
+func (usecase *gameUsecase) Forfeit(gameID string, playerID uint) (err error) {
+	game, err := usecase.gameRepository.Load(gameID)
+	if err != nil {
+		return
+	}
 
-	state, phaseResult, err := game.Next(playerID)
+	state, _, _, err := game.Forfeit(playerID)
 
 	if err != nil {
 		return
 	}
 
-	usecase.notifyStateChangedIfNeeded(game, state, phaseResult, oldPhase)
-	usecase.deleteIfNeeded(game, state)
+	usecase.storeOrDelete(game, state)
 
 	return
 }
@@ -189,13 +402,24 @@ func (usecase *gameUsecase) GetRoles(gameID string, playerID uint) (roles map[ui
 	return
 }
 
-func (usecase *gameUsecase) ObserveState(gameID string, playerID uint) (ch <-chan domain.StateChange, err error) {
+func (usecase *gameUsecase) GetLoverID(gameID string, playerID uint) (loverID uint, err error) {
 	game, err := usecase.gameRepository.Load(gameID)
 	if err != nil {
 		return
 	}
 
-	ch = game.ObserveState(playerID)
+	loverID, err = game.GetLoverID(playerID)
+
+	return
+}
+
+func (usecase *gameUsecase) ObserveState(gameID string, playerID uint, lastSeenSequence uint64) (backlog []domain.StateChange, observer *domain.StateObserver, err error) {
+	game, err := usecase.gameRepository.Load(gameID)
+	if err != nil {
+		return
+	}
+
+	backlog, observer, err = game.ObserveState(playerID, lastSeenSequence)
 
 	return
 }
@@ -211,30 +435,90 @@ func (usecase *gameUsecase) UnobserveState(gameID string, playerID uint) (err er
 	return
 }
 
-func (usecase *gameUsecase) notifyStateChangedIfNeeded(game *domain.Game, state domain.State, phaseResult domain.PhaseResult, oldPhase domain.Phase) {
-	if state.Phase != oldPhase {
-		stateChange := domain.StateChange{
-			State:    state,
-			OldPhase: oldPhase,
-		}
+func (usecase *gameUsecase) SendMessage(gameID string, playerID uint, text string) (err error) {
+	game, err := usecase.gameRepository.Load(gameID)
+	if err != nil {
+		return
+	}
 
-		if phaseResult.Winner != domain.Neutral {
-			stateChange.ChangeType = domain.GameOver
-			stateChange.Winner = phaseResult.Winner
-		} else if phaseResult.KilledPlayerID > 0 {
-			stateChange.ChangeType = domain.PhaseChanged
-			stateChange.KilledPlayerID = phaseResult.KilledPlayerID
-		} else {
-			stateChange.ChangeType = domain.PhaseChangedWithoutKilling
-		}
+	_, err = game.SendMessage(playerID, text)
+
+	return
+}
+
+func (usecase *gameUsecase) ObserveMessages(gameID string, playerID uint) (backlog []domain.Message, observer *domain.MessageObserver, err error) {
+	game, err := usecase.gameRepository.Load(gameID)
+	if err != nil {
+		return
+	}
+
+	backlog, observer, err = game.ObserveMessages(playerID)
+
+	return
+}
 
-		game.NotifyStateChanged(stateChange)
+func (usecase *gameUsecase) UnobserveMessages(gameID string, playerID uint) (err error) {
+	game, err := usecase.gameRepository.Load(gameID)
+	if err != nil {
+		return
 	}
+
+	game.UnobserveMessages(playerID)
+
+	return
 }
 
-func (usecase *gameUsecase) deleteIfNeeded(game *domain.Game, state domain.State) {
+func (usecase *gameUsecase) ObservePrivateEvents(gameID string, playerID uint) (backlog []domain.PrivateEvent, observer *domain.PrivateEventObserver, err error) {
+	game, err := usecase.gameRepository.Load(gameID)
+	if err != nil {
+		return
+	}
+
+	backlog, observer, err = game.ObservePrivateEvents(playerID)
+
+	return
+}
+
+func (usecase *gameUsecase) UnobservePrivateEvents(gameID string, playerID uint) (err error) {
+	game, err := usecase.gameRepository.Load(gameID)
+	if err != nil {
+		return
+	}
+
+	game.UnobservePrivateEvents(playerID)
+
+	return
+}
+
+func (usecase *gameUsecase) ObserveLobby(userID uint) (ch <-chan domain.LobbyEvent) {
+	return usecase.lobby.Observe(userID)
+}
+
+func (usecase *gameUsecase) UnobserveLobby(userID uint) {
+	usecase.lobby.Unobserve(userID)
+}
+
+func (usecase *gameUsecase) storeOrDelete(game *domain.Game, state domain.State) {
 	if state.Phase == domain.End {
 		game.Dispose()
-		usecase.gameRepository.Delete(game.ID)
+		usecase.gameRepository.Store(game)
+		usecase.notifyLobby(game, domain.GameEnded, state)
+
+		time.AfterFunc(endedGameRetention, func() {
+			usecase.gameRepository.Delete(game.ID)
+		})
+	} else {
+		usecase.gameRepository.Store(game)
+	}
+}
+
+func (usecase *gameUsecase) notifyLobby(game *domain.Game, eventType domain.LobbyEventType, state domain.State) {
+	if game.IsPrivate {
+		return
 	}
+
+	usecase.lobby.Notify(domain.LobbyEvent{
+		Type:  eventType,
+		State: state,
+	})
 }